@@ -2,7 +2,6 @@ package caching
 
 import (
 	"context"
-	"github.com/redis/go-redis/v9"
 	"time"
 )
 
@@ -17,5 +16,20 @@ type CacheService interface {
 
 	Exists(ctx context.Context, key string) (bool, error)
 
-	Subscribe(ctx context.Context, channel string) *redis.PubSub
+	// Subscribe returns once the subscription is active, so messages published afterwards
+	// are delivered
+	Subscribe(ctx context.Context, channel string) (Subscription, error)
+}
+
+// Message is a message received on a pub/sub channel
+type Message struct {
+	Channel string
+	Payload string
+}
+
+// Subscription delivers the messages published on a channel until it is closed
+type Subscription interface {
+	// Channel is closed once the subscription is closed
+	Channel() <-chan Message
+	Close() error
 }
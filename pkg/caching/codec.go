@@ -0,0 +1,89 @@
+package caching
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/ugorji/go/codec"
+	"google.golang.org/protobuf/proto"
+)
+
+// Codec turns cached values into bytes and back. Unmarshal receives a pointer to the value
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+var (
+	// JSONCodec uses encoding/json
+	JSONCodec Codec = jsonCodec{}
+	// MsgpackCodec is more compact than JSON and reads the same json struct tags
+	MsgpackCodec Codec = msgpackCodec{handle: newMsgpackHandle()}
+	// ProtoCodec works with generated protobuf messages, cached as pointers
+	ProtoCodec Codec = protoCodec{}
+)
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+type msgpackCodec struct {
+	handle *codec.MsgpackHandle
+}
+
+func newMsgpackHandle() *codec.MsgpackHandle {
+	h := &codec.MsgpackHandle{}
+	h.WriteExt = true // str8 and bin types, needed to tell strings from bytes
+	h.TypeInfos = codec.NewTypeInfos([]string{"codec", "json"})
+	return h
+}
+
+func (c msgpackCodec) Marshal(v interface{}) ([]byte, error) {
+	var data []byte
+	if err := codec.NewEncoderBytes(&data, c.handle).Encode(v); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (c msgpackCodec) Unmarshal(data []byte, v interface{}) error {
+	return codec.NewDecoderBytes(data, c.handle).Decode(v)
+}
+
+type protoCodec struct{}
+
+func (protoCodec) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a protobuf message", v)
+	}
+	return proto.Marshal(msg)
+}
+
+// Unmarshal accepts a message or a pointer to a message pointer, which is allocated when nil
+func (protoCodec) Unmarshal(data []byte, v interface{}) error {
+	if msg, ok := v.(proto.Message); ok {
+		return proto.Unmarshal(data, msg)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Ptr {
+		return fmt.Errorf("%T is not a pointer to a protobuf message", v)
+	}
+	target := rv.Elem()
+	if target.IsNil() {
+		target.Set(reflect.New(target.Type().Elem()))
+	}
+	msg, ok := target.Interface().(proto.Message)
+	if !ok {
+		return fmt.Errorf("%T is not a pointer to a protobuf message", v)
+	}
+	return proto.Unmarshal(data, msg)
+}
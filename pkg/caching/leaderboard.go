@@ -0,0 +1,121 @@
+package caching
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Period splits a leaderboard into time buckets, each with its own ranking
+type Period string
+
+const (
+	PeriodAllTime Period = ""
+	PeriodDaily   Period = "daily"
+	PeriodWeekly  Period = "weekly"
+	PeriodMonthly Period = "monthly"
+)
+
+const (
+	DefaultLeaderboardLimit = 10
+	MaxLeaderboardLimit     = 1000
+)
+
+// Board identifies a leaderboard, e.g. Board{Name: "xp", Period: PeriodWeekly}
+type Board struct {
+	Name   string
+	Period Period
+}
+
+// SourceBoard is the board of one integration source, e.g. "xp:github"
+func SourceBoard(name, source string, period Period) Board {
+	return Board{Name: name + ":" + source, Period: period}
+}
+
+// LeaderboardEntry is a member with its score and 1-based rank, highest score first
+type LeaderboardEntry struct {
+	Member string  `json:"member"`
+	Score  float64 `json:"score"`
+	Rank   int64   `json:"rank"`
+}
+
+// Leaderboard ranks members by score. Every call takes the time that selects the bucket
+// of time-bucketed boards; all-time boards ignore it. Members with equal scores are
+// ordered by member, descending, like Redis sorted sets
+type Leaderboard interface {
+	// IncrementScore adds delta to the score of the member and returns the new score
+	IncrementScore(ctx context.Context, board Board, at time.Time, member string, delta float64) (float64, error)
+	// Top returns the best entries, limit defaults to DefaultLeaderboardLimit
+	Top(ctx context.Context, board Board, at time.Time, limit int) ([]LeaderboardEntry, error)
+	// Rank returns the entry of the member, false when it has no score
+	Rank(ctx context.Context, board Board, at time.Time, member string) (LeaderboardEntry, bool, error)
+	// Around returns the member with up to n neighbours above and below it, empty when it
+	// has no score
+	Around(ctx context.Context, board Board, at time.Time, member string, n int) ([]LeaderboardEntry, error)
+	Remove(ctx context.Context, board Board, at time.Time, member string) error
+}
+
+type LeaderboardConfig struct {
+	// Prefix of the keys, defaults to "leaderboard"
+	Prefix string
+	// Location in which buckets start and end, defaults to UTC
+	Location *time.Location
+	// Retention keeps a bucket readable after its period ended, e.g. to show last week's
+	// winners. Defaults to 7 days
+	Retention time.Duration
+}
+
+func (cfg LeaderboardConfig) withDefaults() LeaderboardConfig {
+	if cfg.Prefix == "" {
+		cfg.Prefix = "leaderboard"
+	}
+	if cfg.Location == nil {
+		cfg.Location = time.UTC
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = 7 * 24 * time.Hour
+	}
+	return cfg
+}
+
+// bucket returns the key of the board bucket containing at and when it expires. All-time
+// boards never expire
+func (cfg LeaderboardConfig) bucket(board Board, at time.Time) (string, time.Time, error) {
+	key := cfg.Prefix + ":" + board.Name
+	if board.Period == PeriodAllTime {
+		return key, time.Time{}, nil
+	}
+
+	t := at.In(cfg.Location)
+	y, m, d := t.Date()
+
+	var id string
+	var end time.Time
+	switch board.Period {
+	case PeriodDaily:
+		id = t.Format("2006-01-02")
+		end = time.Date(y, m, d+1, 0, 0, 0, 0, cfg.Location)
+	case PeriodWeekly:
+		year, week := t.ISOWeek()
+		id = fmt.Sprintf("%d-W%02d", year, week)
+		sinceMonday := (int(t.Weekday()) + 6) % 7
+		end = time.Date(y, m, d-sinceMonday+7, 0, 0, 0, 0, cfg.Location)
+	case PeriodMonthly:
+		id = t.Format("2006-01")
+		end = time.Date(y, m+1, 1, 0, 0, 0, 0, cfg.Location)
+	default:
+		return "", time.Time{}, fmt.Errorf("unknown leaderboard period %q", board.Period)
+	}
+
+	return key + ":" + id, end.Add(cfg.Retention), nil
+}
+
+func leaderboardLimit(limit int) int {
+	switch {
+	case limit <= 0:
+		return DefaultLeaderboardLimit
+	case limit > MaxLeaderboardLimit:
+		return MaxLeaderboardLimit
+	}
+	return limit
+}
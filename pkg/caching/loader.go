@@ -0,0 +1,235 @@
+package caching
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"time"
+)
+
+type LoadOptions struct {
+	// TTL is how long a loaded value is fresh
+	TTL time.Duration
+	// StaleTTL keeps serving a value this long after it went stale while a single caller
+	// reloads it in the background (stale-while-revalidate). 0 disables it
+	StaleTTL time.Duration
+	// Beta enables early probabilistic refresh: fresh values are reloaded in the background
+	// shortly before they expire, the earlier the slower the loader. 1 is a good start,
+	// higher values refresh earlier. 0 disables it
+	Beta float64
+
+	// Lock, when set, lets a single replica load a missing value while the others wait
+	// for it to appear in the cache
+	Lock Locker
+	// LockTTL bounds how long a replica may hold the lock. Default is 5s
+	LockTTL time.Duration
+	// LockWait is how long other replicas wait for the value before loading it anyway.
+	// Default is LockTTL
+	LockWait time.Duration
+
+	// LoadTimeout bounds a load shared by concurrent misses, which outlives the caller
+	// that started it. Default is 10s
+	LoadTimeout time.Duration
+	// RefreshTimeout bounds background reloads. Default is 10s
+	RefreshTimeout time.Duration
+	// OnError receives errors that cannot be returned to the caller: failed background
+	// reloads and values that could not be cached
+	OnError func(key string, err error)
+}
+
+const lockPollInterval = 50 * time.Millisecond
+
+func (o LoadOptions) withDefaults() LoadOptions {
+	if o.LockTTL <= 0 {
+		o.LockTTL = 5 * time.Second
+	}
+	if o.LockWait <= 0 {
+		o.LockWait = o.LockTTL
+	}
+	if o.LoadTimeout <= 0 {
+		o.LoadTimeout = 10 * time.Second
+	}
+	if o.RefreshTimeout <= 0 {
+		o.RefreshTimeout = 10 * time.Second
+	}
+	if o.OnError == nil {
+		o.OnError = func(string, error) {}
+	}
+	return o
+}
+
+// GetOrLoad returns the cached value under key, calling load on a miss. Concurrent misses
+// in this process share one load call; see LoadOptions for sharing across replicas and
+// serving stale values. Values written by Set never expire early and are treated as fresh
+func GetOrLoad[T any](ctx context.Context, c *TypedCache, key string, opts LoadOptions, load func(ctx context.Context) (T, error)) (T, error) {
+	opts = opts.withDefaults()
+
+	value, meta, found, err := lookup[T](ctx, c, key)
+	if err != nil {
+		return value, err
+	}
+	if found {
+		now := time.Now()
+		switch {
+		case meta == nil:
+		case now.Before(meta.freshUntil):
+			if refreshEarly(meta, opts.Beta, now) {
+				refreshInBackground(ctx, c, key, opts, load)
+			}
+		case now.Before(meta.freshUntil.Add(opts.StaleTTL)):
+			refreshInBackground(ctx, c, key, opts, load)
+		default:
+			found = false
+		}
+	}
+	if found {
+		return value, nil
+	}
+
+	// The load is shared, so it must not fail for everyone when the caller that started
+	// it goes away. Each caller still stops waiting when its own context is done
+	ch := c.loads.DoChan(flightKey[T](key), func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), opts.LoadTimeout)
+		defer cancel()
+		return loadShared(ctx, c, key, opts, load)
+	})
+
+	var zero T
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return zero, res.Err
+		}
+		value, ok := res.Val.(T)
+		if !ok && res.Val != nil {
+			return zero, fmt.Errorf("shared load of %s returned %T instead of %v", key, res.Val, reflect.TypeFor[T]())
+		}
+		return value, nil
+	}
+}
+
+func lookup[T any](ctx context.Context, c *TypedCache, key string) (T, *entryMeta, bool, error) {
+	var value T
+
+	raw, err := c.cache.Get(ctx, key)
+	if err != nil {
+		return value, nil, false, fmt.Errorf("failed to get %s: %w", key, err)
+	}
+	if raw == "" {
+		return value, nil, false, nil
+	}
+
+	value, meta, err := decode[T](c, key, raw)
+	if err != nil {
+		return value, nil, false, err
+	}
+	return value, meta, true, nil
+}
+
+// refreshEarly decides whether a fresh value is reloaded now. The chance grows as expiry
+// gets closer and with the time the last load took (XFetch)
+func refreshEarly(meta *entryMeta, beta float64, now time.Time) bool {
+	if beta <= 0 || meta.loadTime <= 0 {
+		return false
+	}
+	gap := time.Duration(-float64(meta.loadTime) * beta * math.Log(1-rand.Float64()))
+	return !now.Add(gap).Before(meta.freshUntil)
+}
+
+// refreshInBackground reloads the value without blocking the caller. It shares the load
+// of concurrent misses and refreshes, so at most one reload per key runs at a time
+func refreshInBackground[T any](ctx context.Context, c *TypedCache, key string, opts LoadOptions, load func(ctx context.Context) (T, error)) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), opts.RefreshTimeout)
+	ch := c.loads.DoChan(flightKey[T](key), func() (interface{}, error) {
+		return loadShared(ctx, c, key, opts, load)
+	})
+
+	go func() {
+		defer cancel()
+		if res := <-ch; res.Err != nil {
+			opts.OnError(key, res.Err)
+		}
+	}()
+}
+
+// flightKey separates the shared loads of callers that read the same key as different
+// types, which would otherwise be handed each other's values
+func flightKey[T any](key string) string {
+	return reflect.TypeFor[T]().String() + "|" + key
+}
+
+// loadShared loads the value while holding the replica lock, if any. Replicas that do not
+// get the lock wait for the holder to cache the value
+func loadShared[T any](ctx context.Context, c *TypedCache, key string, opts LoadOptions, load func(ctx context.Context) (T, error)) (T, error) {
+	if opts.Lock == nil {
+		return loadAndStore(ctx, c, key, opts, load)
+	}
+
+	lockKey := key + ":lock"
+	unlock, err := opts.Lock.TryLock(ctx, lockKey, opts.LockTTL)
+	if err != nil {
+		// Without the lock the value can still be loaded, only less efficiently
+		opts.OnError(key, err)
+		return loadAndStore(ctx, c, key, opts, load)
+	}
+	if unlock != nil {
+		defer func() {
+			if err := unlock(context.WithoutCancel(ctx)); err != nil {
+				opts.OnError(key, err)
+			}
+		}()
+		return loadAndStore(ctx, c, key, opts, load)
+	}
+
+	deadline := time.Now().Add(opts.LockWait)
+	ticker := time.NewTicker(lockPollInterval)
+	defer ticker.Stop()
+
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		case <-ticker.C:
+		}
+
+		value, meta, found, err := lookup[T](ctx, c, key)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		if found && (meta == nil || time.Now().Before(meta.freshUntil)) {
+			return value, nil
+		}
+	}
+
+	return loadAndStore(ctx, c, key, opts, load)
+}
+
+// loadAndStore calls the loader and caches its value. Caching errors are reported to
+// OnError, the loaded value is returned regardless
+func loadAndStore[T any](ctx context.Context, c *TypedCache, key string, opts LoadOptions, load func(ctx context.Context) (T, error)) (T, error) {
+	start := time.Now()
+	value, err := load(ctx)
+	if err != nil {
+		return value, err
+	}
+
+	// Without a TTL the value is stored like Set does and stays fresh forever
+	var meta *entryMeta
+	var expiration time.Duration
+	if opts.TTL > 0 {
+		now := time.Now()
+		meta = &entryMeta{freshUntil: now.Add(opts.TTL), loadTime: now.Sub(start)}
+		expiration = opts.TTL + opts.StaleTTL
+	}
+
+	if err := set(ctx, c, key, value, meta, expiration); err != nil {
+		opts.OnError(key, err)
+	}
+	return value, nil
+}
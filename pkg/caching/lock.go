@@ -0,0 +1,60 @@
+package caching
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Locker grants short-lived locks shared by every replica
+type Locker interface {
+	// TryLock returns the function releasing the lock, or nil when someone else holds it.
+	// The lock expires on its own after ttl
+	TryLock(ctx context.Context, key string, ttl time.Duration) (func(context.Context) error, error)
+}
+
+// Ensure RedisService implements Locker
+var _ Locker = (*RedisService)(nil)
+
+// unlockScript deletes the lock only while it still holds our token, so a holder that
+// outlived its ttl cannot release the lock of the next one
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+func (c *RedisService) TryLock(ctx context.Context, key string, ttl time.Duration) (func(context.Context) error, error) {
+	token, err := lockToken()
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := c.client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire lock %s: %w", key, err)
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	return func(ctx context.Context) error {
+		if err := unlockScript.Run(ctx, c.client, []string{key}, token).Err(); err != nil {
+			return fmt.Errorf("failed to release lock %s: %w", key, err)
+		}
+		return nil
+	}, nil
+}
+
+func lockToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate lock token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
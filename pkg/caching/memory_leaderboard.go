@@ -0,0 +1,143 @@
+package caching
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryLeaderboard is an in-process Leaderboard for tests and local runs. It ranks
+// exactly like RedisLeaderboard
+type MemoryLeaderboard struct {
+	config LeaderboardConfig
+
+	mu     sync.Mutex
+	boards map[string]*memoryBoard
+}
+
+type memoryBoard struct {
+	scores   map[string]float64
+	expireAt time.Time
+}
+
+// Ensure MemoryLeaderboard implements Leaderboard
+var _ Leaderboard = (*MemoryLeaderboard)(nil)
+
+func NewMemoryLeaderboard(cfg LeaderboardConfig) *MemoryLeaderboard {
+	return &MemoryLeaderboard{config: cfg.withDefaults(), boards: make(map[string]*memoryBoard)}
+}
+
+func (l *MemoryLeaderboard) IncrementScore(_ context.Context, board Board, at time.Time, member string, delta float64) (float64, error) {
+	key, expireAt, err := l.config.bucket(board, at)
+	if err != nil {
+		return 0, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.board(key)
+	if b == nil {
+		b = &memoryBoard{scores: make(map[string]float64)}
+		l.boards[key] = b
+	}
+	b.scores[member] += delta
+	b.expireAt = expireAt
+	return b.scores[member], nil
+}
+
+func (l *MemoryLeaderboard) Top(_ context.Context, board Board, at time.Time, limit int) ([]LeaderboardEntry, error) {
+	entries, err := l.ranked(board, at)
+	if err != nil {
+		return nil, err
+	}
+	return entries[:min(len(entries), leaderboardLimit(limit))], nil
+}
+
+func (l *MemoryLeaderboard) Rank(_ context.Context, board Board, at time.Time, member string) (LeaderboardEntry, bool, error) {
+	entries, err := l.ranked(board, at)
+	if err != nil {
+		return LeaderboardEntry{}, false, err
+	}
+	for _, e := range entries {
+		if e.Member == member {
+			return e, true, nil
+		}
+	}
+	return LeaderboardEntry{}, false, nil
+}
+
+func (l *MemoryLeaderboard) Around(_ context.Context, board Board, at time.Time, member string, n int) ([]LeaderboardEntry, error) {
+	entries, err := l.ranked(board, at)
+	if err != nil {
+		return nil, err
+	}
+
+	n = min(max(n, 0), MaxLeaderboardLimit/2)
+	for i, e := range entries {
+		if e.Member == member {
+			return entries[max(i-n, 0):min(i+n+1, len(entries))], nil
+		}
+	}
+	return nil, nil
+}
+
+func (l *MemoryLeaderboard) Remove(_ context.Context, board Board, at time.Time, member string) error {
+	key, _, err := l.config.bucket(board, at)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b := l.board(key); b != nil {
+		delete(b.scores, member)
+	}
+	return nil
+}
+
+// ranked returns every entry of the bucket, best first
+func (l *MemoryLeaderboard) ranked(board Board, at time.Time) ([]LeaderboardEntry, error) {
+	key, _, err := l.config.bucket(board, at)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.board(key)
+	if b == nil {
+		return []LeaderboardEntry{}, nil
+	}
+
+	entries := make([]LeaderboardEntry, 0, len(b.scores))
+	for member, score := range b.scores {
+		entries = append(entries, LeaderboardEntry{Member: member, Score: score})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].Member > entries[j].Member
+	})
+	for i := range entries {
+		entries[i].Rank = int64(i) + 1
+	}
+	return entries, nil
+}
+
+// board returns the live bucket under key, dropping it once expired. Callers hold mu
+func (l *MemoryLeaderboard) board(key string) *memoryBoard {
+	b, ok := l.boards[key]
+	if !ok {
+		return nil
+	}
+	if !b.expireAt.IsZero() && !time.Now().Before(b.expireAt) {
+		delete(l.boards, key)
+		return nil
+	}
+	return b
+}
@@ -0,0 +1,283 @@
+package caching
+
+import (
+	"container/list"
+	"context"
+	"encoding"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// subscriptionBuffer is how many messages a slow subscriber may fall behind before newer
+// messages are dropped, like Redis drops them for slow clients
+const subscriptionBuffer = 100
+
+type MemoryConfig struct {
+	// MaxEntries evicts the least recently used entries beyond this count, 0 means no limit
+	MaxEntries int
+}
+
+// MemoryService is an in-process CacheService for tests and local runs. Values are stored
+// as strings the way go-redis converts them, and pub/sub works between subscribers of the
+// same MemoryService
+type MemoryService struct {
+	config MemoryConfig
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// lru holds *memoryEntry, most recently used first
+	lru         *list.List
+	subscribers map[string]map[*memorySubscription]struct{}
+}
+
+type memoryEntry struct {
+	key      string
+	value    string
+	expireAt time.Time // zero means no expiry
+}
+
+// Ensure MemoryService implements CacheService and Locker
+var (
+	_ CacheService = (*MemoryService)(nil)
+	_ Locker       = (*MemoryService)(nil)
+)
+
+func NewMemoryService(cfg MemoryConfig) *MemoryService {
+	return &MemoryService{
+		config:      cfg,
+		entries:     make(map[string]*list.Element),
+		lru:         list.New(),
+		subscribers: make(map[string]map[*memorySubscription]struct{}),
+	}
+}
+
+func (c *MemoryService) Set(_ context.Context, key string, value interface{}, expiration time.Duration) error {
+	s, err := stringValue(value)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.set(key, s, expiration, time.Now())
+	return nil
+}
+
+// Get returns an empty string for missing keys, like RedisService
+func (c *MemoryService) Get(_ context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e := c.lookup(key, time.Now()); e != nil {
+		return e.value, nil
+	}
+	return "", nil
+}
+
+func (c *MemoryService) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	return nil
+}
+
+func (c *MemoryService) Exists(_ context.Context, key string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lookup(key, time.Now()) != nil, nil
+}
+
+func (c *MemoryService) Publish(_ context.Context, channel, message string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	msg := Message{Channel: channel, Payload: message}
+	for sub := range c.subscribers[channel] {
+		select {
+		case sub.messages <- msg:
+		default:
+		}
+	}
+	return nil
+}
+
+func (c *MemoryService) Subscribe(_ context.Context, channel string) (Subscription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sub := &memorySubscription{service: c, channel: channel, messages: make(chan Message, subscriptionBuffer)}
+	if c.subscribers[channel] == nil {
+		c.subscribers[channel] = make(map[*memorySubscription]struct{})
+	}
+	c.subscribers[channel][sub] = struct{}{}
+	return sub, nil
+}
+
+// TryLock sets the lock key only when it is missing, like RedisService
+func (c *MemoryService) TryLock(_ context.Context, key string, ttl time.Duration) (func(context.Context) error, error) {
+	token, err := lockToken()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if c.lookup(key, now) != nil {
+		return nil, nil
+	}
+	c.set(key, token, ttl, now)
+
+	return func(context.Context) error {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if e := c.lookup(key, time.Now()); e != nil && e.value == token {
+			c.remove(c.entries[key])
+		}
+		return nil
+	}, nil
+}
+
+// Close closes every subscription
+func (c *MemoryService) Close() error {
+	c.mu.Lock()
+	subs := c.subscribers
+	c.subscribers = make(map[string]map[*memorySubscription]struct{})
+	c.mu.Unlock()
+
+	for _, byChannel := range subs {
+		for sub := range byChannel {
+			sub.closeOnce.Do(func() { close(sub.messages) })
+		}
+	}
+	return nil
+}
+
+// set stores the value, evicting the least recently used entries beyond MaxEntries.
+// Callers hold mu
+func (c *MemoryService) set(key, value string, expiration time.Duration, now time.Time) {
+	var expireAt time.Time
+	if expiration > 0 {
+		expireAt = now.Add(expiration)
+	}
+
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*memoryEntry)
+		e.value = value
+		if expiration != redis.KeepTTL {
+			e.expireAt = expireAt
+		}
+		c.lru.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&memoryEntry{key: key, value: value, expireAt: expireAt})
+	for c.config.MaxEntries > 0 && c.lru.Len() > c.config.MaxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// lookup returns the live entry under key and marks it as used. Expired entries are
+// dropped on access. Callers hold mu
+func (c *MemoryService) lookup(key string, now time.Time) *memoryEntry {
+	el, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+
+	e := el.Value.(*memoryEntry)
+	if !e.expireAt.IsZero() && !now.Before(e.expireAt) {
+		c.remove(el)
+		return nil
+	}
+	c.lru.MoveToFront(el)
+	return e
+}
+
+func (c *MemoryService) remove(el *list.Element) {
+	delete(c.entries, el.Value.(*memoryEntry).key)
+	c.lru.Remove(el)
+}
+
+type memorySubscription struct {
+	service   *MemoryService
+	channel   string
+	messages  chan Message
+	closeOnce sync.Once
+}
+
+func (s *memorySubscription) Channel() <-chan Message {
+	return s.messages
+}
+
+func (s *memorySubscription) Close() error {
+	s.service.mu.Lock()
+	delete(s.service.subscribers[s.channel], s)
+	s.service.mu.Unlock()
+
+	s.closeOnce.Do(func() { close(s.messages) })
+	return nil
+}
+
+// stringValue converts a value the way go-redis writes command arguments
+func stringValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case int:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int8:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int16:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		if v {
+			return "1", nil
+		}
+		return "0", nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case time.Duration:
+		return strconv.FormatInt(v.Nanoseconds(), 10), nil
+	case encoding.BinaryMarshaler:
+		b, err := v.MarshalBinary()
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+	return "", fmt.Errorf("can't marshal %T (implement encoding.BinaryMarshaler)", v)
+}
@@ -0,0 +1,149 @@
+package caching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// aroundScript reads a member's rank and its neighbours in one step, so the window
+// cannot shift between the two reads. It returns the 0-based rank of the first entry
+// and the members with their scores
+var aroundScript = redis.NewScript(`
+local rank = redis.call('ZREVRANK', KEYS[1], ARGV[1])
+if not rank then
+	return false
+end
+local n = tonumber(ARGV[2])
+local start = math.max(rank - n, 0)
+return {start, redis.call('ZREVRANGE', KEYS[1], start, rank + n, 'WITHSCORES')}
+`)
+
+// RedisLeaderboard keeps one sorted set per board bucket. Buckets expire on their own
+// once the retention after their period is over
+type RedisLeaderboard struct {
+	client *redis.Client
+	config LeaderboardConfig
+}
+
+// Ensure RedisLeaderboard implements Leaderboard
+var _ Leaderboard = (*RedisLeaderboard)(nil)
+
+// NewRedisLeaderboard keeps the boards on the connection of the RedisService, which
+// stays responsible for closing it
+func NewRedisLeaderboard(svc *RedisService, cfg LeaderboardConfig) *RedisLeaderboard {
+	return &RedisLeaderboard{client: svc.client, config: cfg.withDefaults()}
+}
+
+func (l *RedisLeaderboard) IncrementScore(ctx context.Context, board Board, at time.Time, member string, delta float64) (float64, error) {
+	key, expireAt, err := l.config.bucket(board, at)
+	if err != nil {
+		return 0, err
+	}
+
+	var score *redis.FloatCmd
+	_, err = l.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		score = pipe.ZIncrBy(ctx, key, delta, member)
+		if !expireAt.IsZero() {
+			pipe.ExpireAt(ctx, key, expireAt)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment score: %w", err)
+	}
+	return score.Val(), nil
+}
+
+func (l *RedisLeaderboard) Top(ctx context.Context, board Board, at time.Time, limit int) ([]LeaderboardEntry, error) {
+	key, _, err := l.config.bucket(board, at)
+	if err != nil {
+		return nil, err
+	}
+	return l.rangeByRank(ctx, key, 0, int64(leaderboardLimit(limit))-1)
+}
+
+func (l *RedisLeaderboard) Rank(ctx context.Context, board Board, at time.Time, member string) (LeaderboardEntry, bool, error) {
+	key, _, err := l.config.bucket(board, at)
+	if err != nil {
+		return LeaderboardEntry{}, false, err
+	}
+
+	var rank *redis.IntCmd
+	var score *redis.FloatCmd
+	_, err = l.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		rank = pipe.ZRevRank(ctx, key, member)
+		score = pipe.ZScore(ctx, key, member)
+		return nil
+	})
+	if errors.Is(err, redis.Nil) {
+		return LeaderboardEntry{}, false, nil
+	}
+	if err != nil {
+		return LeaderboardEntry{}, false, fmt.Errorf("failed to get rank: %w", err)
+	}
+	return LeaderboardEntry{Member: member, Score: score.Val(), Rank: rank.Val() + 1}, true, nil
+}
+
+func (l *RedisLeaderboard) Around(ctx context.Context, board Board, at time.Time, member string, n int) ([]LeaderboardEntry, error) {
+	key, _, err := l.config.bucket(board, at)
+	if err != nil {
+		return nil, err
+	}
+
+	n = min(max(n, 0), MaxLeaderboardLimit/2)
+	res, err := aroundScript.Run(ctx, l.client, []string{key}, member, n).Slice()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read leaderboard: %w", err)
+	}
+	if len(res) != 2 {
+		return nil, fmt.Errorf("failed to read leaderboard: unexpected reply %v", res)
+	}
+
+	start, _ := res[0].(int64)
+	flat, _ := res[1].([]interface{})
+	entries := make([]LeaderboardEntry, 0, len(flat)/2)
+	for i := 0; i+1 < len(flat); i += 2 {
+		member, _ := flat[i].(string)
+		raw, _ := flat[i+1].(string)
+		score, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse score of %s: %w", member, err)
+		}
+		entries = append(entries, LeaderboardEntry{Member: member, Score: score, Rank: start + int64(i/2) + 1})
+	}
+	return entries, nil
+}
+
+func (l *RedisLeaderboard) Remove(ctx context.Context, board Board, at time.Time, member string) error {
+	key, _, err := l.config.bucket(board, at)
+	if err != nil {
+		return err
+	}
+	if err := l.client.ZRem(ctx, key, member).Err(); err != nil {
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+	return nil
+}
+
+// rangeByRank reads the entries between two 0-based ranks, both included
+func (l *RedisLeaderboard) rangeByRank(ctx context.Context, key string, start, stop int64) ([]LeaderboardEntry, error) {
+	zs, err := l.client.ZRevRangeWithScores(ctx, key, start, stop).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read leaderboard: %w", err)
+	}
+
+	entries := make([]LeaderboardEntry, 0, len(zs))
+	for i, z := range zs {
+		member, _ := z.Member.(string)
+		entries = append(entries, LeaderboardEntry{Member: member, Score: z.Score, Rank: start + int64(i) + 1})
+	}
+	return entries, nil
+}
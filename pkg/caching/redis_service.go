@@ -3,6 +3,7 @@ package caching
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -18,8 +19,9 @@ type RedisService struct {
 	client *redis.Client
 }
 
-// NewRedisService initializes Redis client from config
-func NewRedisService(cfg RedisConfig) (*RedisService, error) {
+// NewRedisClient connects to Redis and checks the connection. Every Redis-backed store
+// creates its client here
+func NewRedisClient(cfg RedisConfig) (*redis.Client, error) {
 	client := redis.NewClient(&redis.Options{
 		Addr:     cfg.Addr,
 		Password: cfg.Password,
@@ -30,9 +32,19 @@ func NewRedisService(cfg RedisConfig) (*RedisService, error) {
 	defer cancel()
 
 	if _, err := client.Ping(ctx).Result(); err != nil {
+		_ = client.Close()
 		return nil, fmt.Errorf("failed to connect to redis: %w", err)
 	}
 
+	return client, nil
+}
+
+// NewRedisService initializes Redis client from config
+func NewRedisService(cfg RedisConfig) (*RedisService, error) {
+	client, err := NewRedisClient(cfg)
+	if err != nil {
+		return nil, err
+	}
 	return &RedisService{client: client}, nil
 }
 
@@ -64,8 +76,49 @@ func (c *RedisService) Exists(ctx context.Context, key string) (bool, error) {
 	return res > 0, nil
 }
 
-func (c *RedisService) Subscribe(ctx context.Context, channel string) *redis.PubSub {
-	return c.client.Subscribe(ctx, channel)
+func (c *RedisService) Subscribe(ctx context.Context, channel string) (Subscription, error) {
+	pubsub := c.client.Subscribe(ctx, channel)
+	// Wait for the confirmation, go-redis subscribes lazily otherwise
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe to %s: %w", channel, err)
+	}
+
+	sub := &redisSubscription{pubsub: pubsub, messages: make(chan Message), done: make(chan struct{})}
+	go sub.forward()
+	return sub, nil
+}
+
+// redisSubscription turns go-redis messages into Messages
+type redisSubscription struct {
+	pubsub    *redis.PubSub
+	messages  chan Message
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (s *redisSubscription) forward() {
+	defer close(s.messages)
+	for msg := range s.pubsub.Channel() {
+		select {
+		case s.messages <- Message{Channel: msg.Channel, Payload: msg.Payload}:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *redisSubscription) Channel() <-chan Message {
+	return s.messages
+}
+
+func (s *redisSubscription) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		err = s.pubsub.Close()
+	})
+	return err
 }
 
 // Close gracefully closes Redis connection
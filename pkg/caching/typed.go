@@ -0,0 +1,179 @@
+package caching
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// ErrNotFound is returned by Get when the key is missing or expired
+var ErrNotFound = errors.New("cache entry not found")
+
+// Every value starts with a header byte of flags telling how the rest is stored, so
+// compression can be switched on without invalidating existing entries. Values written by
+// GetOrLoad carry freshness metadata between the header and the payload
+const (
+	flagGzip     byte = 1 << 0
+	flagMetadata byte = 1 << 1
+
+	metadataSize = 16
+)
+
+// entryMeta is the freshness metadata of an entry written by GetOrLoad
+type entryMeta struct {
+	freshUntil time.Time
+	// loadTime is how long the loader took, used for early refresh
+	loadTime time.Duration
+}
+
+type TypedConfig struct {
+	// Codec defaults to JSONCodec
+	Codec Codec
+	// CompressAbove gzips encoded values larger than this many bytes, 0 disables compression
+	CompressAbove int
+}
+
+// TypedCache stores typed values in a CacheService, see Get, Set and GetOrLoad
+type TypedCache struct {
+	cache  CacheService
+	config TypedConfig
+	// loads runs one loader per key at a time in this process
+	loads singleflight.Group
+}
+
+func NewTypedCache(cache CacheService, cfg TypedConfig) *TypedCache {
+	if cfg.Codec == nil {
+		cfg.Codec = JSONCodec
+	}
+	return &TypedCache{cache: cache, config: cfg}
+}
+
+// Get reads and decodes the value under key. Returns ErrNotFound when there is none
+func Get[T any](ctx context.Context, c *TypedCache, key string) (T, error) {
+	var value T
+
+	raw, err := c.cache.Get(ctx, key)
+	if err != nil {
+		return value, fmt.Errorf("failed to get %s: %w", key, err)
+	}
+	// Encoded values are never empty because of the header byte
+	if raw == "" {
+		return value, ErrNotFound
+	}
+
+	value, _, err = decode[T](c, key, raw)
+	return value, err
+}
+
+// Set encodes and stores the value under key. A zero expiration keeps it forever
+func Set[T any](ctx context.Context, c *TypedCache, key string, value T, expiration time.Duration) error {
+	return set(ctx, c, key, value, nil, expiration)
+}
+
+func set[T any](ctx context.Context, c *TypedCache, key string, value T, meta *entryMeta, expiration time.Duration) error {
+	data, err := c.config.Codec.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+
+	wrapped, err := c.wrap(data, meta)
+	if err != nil {
+		return fmt.Errorf("failed to compress %s: %w", key, err)
+	}
+	if err := c.cache.Set(ctx, key, wrapped, expiration); err != nil {
+		return fmt.Errorf("failed to set %s: %w", key, err)
+	}
+	return nil
+}
+
+// decode reads a stored value with its metadata, nil for values written by Set
+func decode[T any](c *TypedCache, key, raw string) (T, *entryMeta, error) {
+	var value T
+
+	data, meta, err := c.unwrap([]byte(raw))
+	if err != nil {
+		return value, nil, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	if err := c.config.Codec.Unmarshal(data, &value); err != nil {
+		return value, nil, fmt.Errorf("failed to decode %s: %w", key, err)
+	}
+	return value, meta, nil
+}
+
+func (c *TypedCache) Delete(ctx context.Context, key string) error {
+	return c.cache.Delete(ctx, key)
+}
+
+// wrap prepends the header and the metadata, compressing values above the threshold
+func (c *TypedCache) wrap(data []byte, meta *entryMeta) ([]byte, error) {
+	var buf bytes.Buffer
+
+	var flags byte
+	if meta != nil {
+		flags |= flagMetadata
+	}
+	compress := c.config.CompressAbove > 0 && len(data) > c.config.CompressAbove
+	if compress {
+		flags |= flagGzip
+	}
+	buf.WriteByte(flags)
+
+	if meta != nil {
+		var header [metadataSize]byte
+		binary.BigEndian.PutUint64(header[0:8], uint64(meta.freshUntil.UnixMilli()))
+		binary.BigEndian.PutUint64(header[8:16], uint64(meta.loadTime.Microseconds()))
+		buf.Write(header[:])
+	}
+
+	if !compress {
+		buf.Write(data)
+		return buf.Bytes(), nil
+	}
+
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *TypedCache) unwrap(raw []byte) ([]byte, *entryMeta, error) {
+	flags, data := raw[0], raw[1:]
+	if flags&^(flagGzip|flagMetadata) != 0 {
+		return nil, nil, fmt.Errorf("unknown value flags %#x", flags)
+	}
+
+	var meta *entryMeta
+	if flags&flagMetadata != 0 {
+		if len(data) < metadataSize {
+			return nil, nil, errors.New("truncated metadata")
+		}
+		meta = &entryMeta{
+			freshUntil: time.UnixMilli(int64(binary.BigEndian.Uint64(data[0:8]))),
+			loadTime:   time.Duration(binary.BigEndian.Uint64(data[8:16])) * time.Microsecond,
+		}
+		data = data[metadataSize:]
+	}
+
+	if flags&flagGzip == 0 {
+		return data, meta, nil
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer zr.Close()
+
+	data, err = io.ReadAll(zr)
+	return data, meta, err
+}
@@ -0,0 +1,80 @@
+// Command eventcontract guards the wire format of event payloads.
+//
+//	go run ./cmd/eventcontract check     round-trips golden fixtures (JSON and protobuf) and compares against the released snapshot
+//	go run ./cmd/eventcontract fixtures  writes fixtures for events that have none yet
+//	go run ./cmd/eventcontract snapshot  records the current payloads as the released snapshot
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/metacode-dream-team/MetaCode/pkg/events/contract"
+)
+
+func main() {
+	dir := flag.String("dir", "events/contract", "directory holding fixtures/ and snapshot.json")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: eventcontract [-dir path] check|fixtures|snapshot")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	switch flag.Arg(0) {
+	case "check":
+		os.Exit(check(*dir))
+	case "fixtures":
+		written, err := contract.WriteMissingFixtures(*dir)
+		if err != nil {
+			fail(err)
+		}
+		for _, path := range written {
+			fmt.Println("wrote", path)
+		}
+	case "snapshot":
+		if err := contract.WriteSnapshot(*dir, contract.Current()); err != nil {
+			fail(err)
+		}
+		fmt.Println("wrote", contract.SnapshotPath(*dir))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func check(dir string) int {
+	failed := false
+
+	for _, err := range contract.CheckFixtures(dir) {
+		fmt.Fprintln(os.Stderr, "FIXTURE", err)
+		failed = true
+	}
+	for _, err := range contract.CheckProto(dir) {
+		fmt.Fprintln(os.Stderr, "PROTO", err)
+		failed = true
+	}
+
+	released, err := contract.LoadSnapshot(dir)
+	if err != nil {
+		fail(err)
+	}
+	for _, change := range contract.Compare(released, contract.Current()) {
+		fmt.Println(change)
+		if change.Breaking {
+			failed = true
+		}
+	}
+
+	if failed {
+		fmt.Fprintln(os.Stderr, "event contract check failed")
+		return 1
+	}
+	fmt.Println("event contract check passed")
+	return 0
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
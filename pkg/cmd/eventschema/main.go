@@ -0,0 +1,58 @@
+// Command eventschema writes the JSON Schema of every registered event payload and an
+// AsyncAPI document describing the Kafka topics.
+//
+//	go run ./cmd/eventschema -out ../docs/events
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+	"github.com/metacode-dream-team/MetaCode/pkg/events/schema"
+)
+
+func main() {
+	out := flag.String("out", "docs/events", "output directory")
+	version := flag.String("version", "1.0.0", "AsyncAPI document version")
+	flag.Parse()
+
+	schemaDir := filepath.Join(*out, "schemas")
+	if err := os.MkdirAll(schemaDir, 0o755); err != nil {
+		log.Fatalf("failed to create output directory: %v", err)
+	}
+
+	descriptors := events.Registered()
+	for _, d := range descriptors {
+		path := filepath.Join(schemaDir, d.Type+".schema.json")
+		if err := writeJSON(path, schema.ForEvent(d)); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	doc := schema.AsyncAPI(schema.Info{
+		Title:       "MetaCode events",
+		Version:     *version,
+		Description: "Domain events exchanged between MetaCode services over Kafka.",
+	}, descriptors)
+	if err := writeJSON(filepath.Join(*out, "asyncapi.json"), doc); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Wrote %d event schemas and asyncapi.json to %s\n", len(descriptors), *out)
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
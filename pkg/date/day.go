@@ -0,0 +1,55 @@
+package date
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // services agree on zone rules regardless of the host tz database
+)
+
+// DayLayout formats a calendar date, e.g. "2025-03-14"
+const DayLayout = "2006-01-02"
+
+// LoadLocation resolves an IANA timezone such as "Asia/Almaty". Empty means UTC.
+// "Local" is rejected since it depends on the host
+func LoadLocation(timezone string) (*time.Location, error) {
+	switch timezone {
+	case "":
+		return time.UTC, nil
+	case "Local":
+		return nil, fmt.Errorf("unknown timezone %q: host timezone is not allowed", timezone)
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q: %w", timezone, err)
+	}
+	return loc, nil
+}
+
+// Day returns the local calendar date of t in loc
+func Day(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(DayLayout)
+}
+
+// Today returns the current local calendar date in loc
+func Today(loc *time.Location) string {
+	return Day(time.Now(), loc)
+}
+
+// DayBounds returns the start of the local day containing t and the start of the next
+// one. Days are not always 24h long because of DST transitions
+func DayBounds(t time.Time, loc *time.Location) (start, end time.Time) {
+	local := t.In(loc)
+	start = time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	end = time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, loc)
+	return start, end
+}
+
+// ParseDay parses a calendar date and returns its bounds in loc
+func ParseDay(day string, loc *time.Location) (start, end time.Time, err error) {
+	t, err := time.ParseInLocation(DayLayout, day, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date %q: %w", day, err)
+	}
+	start, end = DayBounds(t, loc)
+	return start, end, nil
+}
@@ -1,15 +1,99 @@
 package events
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventTypeAchievementGranted    = "achievement.granted"
+	EventTypeAchievementProgressed = "achievement.progressed"
+	EventTypeAchievementRevoked    = "achievement.revoked"
+)
+
+type AchievementTier string
 
 const (
-	EventTypeAchievementGranted = "achievement.granted"
+	TierBronze AchievementTier = "bronze"
+	TierSilver AchievementTier = "silver"
+	TierGold   AchievementTier = "gold"
 )
 
+var knownTiers = []AchievementTier{TierBronze, TierSilver, TierGold}
+
+// AchievementTiers returns every tier from lowest to highest
+func AchievementTiers() []AchievementTier {
+	return append([]AchievementTier(nil), knownTiers...)
+}
+
+// Valid reports whether the tier is one of the known tiers
+func (t AchievementTier) Valid() bool {
+	for _, known := range knownTiers {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// AchievementGrantedEvent unlocks an achievement. In all achievement events Tier is empty
+// for achievements without tiers
 type AchievementGrantedEvent struct {
-	UserID        uuid.UUID `json:"user_id"`
-	AchievementID uuid.UUID `json:"achievement_id"`
-	Name          string    `json:"name"`
-	Description   string    `json:"description"`
-	IconURL       *string   `json:"icon_url"`
+	UserID        uuid.UUID       `json:"user_id"`
+	AchievementID uuid.UUID       `json:"achievement_id"`
+	Name          string          `json:"name"`
+	Description   string          `json:"description"`
+	IconURL       *string         `json:"icon_url"`
+	Tier          AchievementTier `json:"tier,omitempty"`
+}
+
+// AchievementProgressed reports progress towards the next tier, e.g. 7 of 10 hard problems
+type AchievementProgressed struct {
+	UserID        uuid.UUID       `json:"user_id"`
+	AchievementID uuid.UUID       `json:"achievement_id"`
+	Tier          AchievementTier `json:"tier,omitempty"`
+	Current       int             `json:"current"`
+	Target        int             `json:"target"`
+	ProgressedAt  time.Time       `json:"progressed_at"`
+}
+
+// AchievementRevoked takes back an achievement granted by mistake
+type AchievementRevoked struct {
+	UserID        uuid.UUID       `json:"user_id"`
+	AchievementID uuid.UUID       `json:"achievement_id"`
+	Tier          AchievementTier `json:"tier,omitempty"`
+	Reason        string          `json:"reason"`               // "granted_in_error", "fraud", ...
+	RevokedBy     *uuid.UUID      `json:"revoked_by,omitempty"` // admin, nil when automatic
+	RevokedAt     time.Time       `json:"revoked_at"`
+}
+
+func (e AchievementGrantedEvent) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireUUID("achievement_id", e.AchievementID)
+	c.requireString("name", e.Name)
+	c.tier("tier", e.Tier)
+	return c.err()
+}
+
+func (e AchievementProgressed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireUUID("achievement_id", e.AchievementID)
+	c.tier("tier", e.Tier)
+	c.nonNegative("current", e.Current)
+	c.positive("target", e.Target)
+	c.requireTime("progressed_at", e.ProgressedAt)
+	return c.err()
+}
+
+func (e AchievementRevoked) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireUUID("achievement_id", e.AchievementID)
+	c.tier("tier", e.Tier)
+	c.requireString("reason", e.Reason)
+	c.requireTime("revoked_at", e.RevokedAt)
+	return c.err()
 }
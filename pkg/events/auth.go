@@ -0,0 +1,154 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventTypeLoginSucceeded  = "auth.login.succeeded"
+	EventTypeLoginFailed     = "auth.login.failed"
+	EventTypeNewDeviceLogin  = "auth.login.new-device"
+	EventTypePasswordChanged = "auth.password.changed"
+	EventTypeEmailChanged    = "auth.email.changed"
+	EventTypeSessionRevoked  = "auth.session.revoked"
+	EventTypeMFAEnabled      = "auth.mfa.enabled"
+)
+
+// Security events are published by the Keycloak event listener. Every event carries the
+// client IP, its user agent and the Keycloak session it happened in. The client details
+// are personal data and encrypted per user, except in LoginFailed
+
+type LoginSucceeded struct {
+	UserID     uuid.UUID `json:"user_id"`
+	SessionID  string    `json:"session_id"`
+	IPAddress  string    `json:"ip_address" pii:"true"`
+	UserAgent  string    `json:"user_agent" pii:"true"`
+	Method     string    `json:"method,omitempty"` // "password", "github", "otp", ...
+	LoggedInAt time.Time `json:"logged_in_at"`
+}
+
+// LoginFailed has no user when the username is unknown, so its fields cannot be
+// encrypted per user. It stays plaintext, including the username, IP address and user
+// agent, and Forget does not make it unreadable
+type LoginFailed struct {
+	UserID    *uuid.UUID `json:"user_id,omitempty"`
+	Username  string     `json:"username"` // as typed by the client
+	SessionID string     `json:"session_id,omitempty"`
+	IPAddress string     `json:"ip_address"`
+	UserAgent string     `json:"user_agent"`
+	Reason    string     `json:"reason"` // "invalid_credentials", "user_not_found", "user_disabled", "invalid_otp", ...
+	FailedAt  time.Time  `json:"failed_at"`
+}
+
+// NewDeviceLogin follows LoginSucceeded when the device was never seen for the user
+type NewDeviceLogin struct {
+	UserID     uuid.UUID `json:"user_id"`
+	SessionID  string    `json:"session_id"`
+	IPAddress  string    `json:"ip_address" pii:"true"`
+	UserAgent  string    `json:"user_agent" pii:"true"`
+	DeviceID   string    `json:"device_id,omitempty" pii:"true"` // fingerprint of the device
+	Location   string    `json:"location,omitempty" pii:"true"`  // approximate, e.g. "Almaty, KZ"
+	LoggedInAt time.Time `json:"logged_in_at"`
+}
+
+type PasswordChanged struct {
+	UserID    uuid.UUID `json:"user_id"`
+	SessionID string    `json:"session_id"`
+	IPAddress string    `json:"ip_address" pii:"true"`
+	UserAgent string    `json:"user_agent" pii:"true"`
+	Reset     bool      `json:"reset"` // true when changed through the forgot-password flow
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+type EmailChanged struct {
+	UserID    uuid.UUID `json:"user_id"`
+	OldEmail  string    `json:"old_email" pii:"true"`
+	NewEmail  string    `json:"new_email" pii:"true"`
+	SessionID string    `json:"session_id"`
+	IPAddress string    `json:"ip_address" pii:"true"`
+	UserAgent string    `json:"user_agent" pii:"true"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+type SessionRevoked struct {
+	UserID    uuid.UUID  `json:"user_id"`
+	SessionID string     `json:"session_id"`            // the revoked session
+	IPAddress string     `json:"ip_address" pii:"true"` // of the client that revoked it
+	UserAgent string     `json:"user_agent" pii:"true"`
+	Reason    string     `json:"reason"`               // "logout", "logout_all", "password_changed", "admin", ...
+	RevokedBy *uuid.UUID `json:"revoked_by,omitempty"` // admin, nil when revoked by the user
+	RevokedAt time.Time  `json:"revoked_at"`
+}
+
+type MFAEnabled struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Method    string    `json:"method"` // "totp", "webauthn"
+	SessionID string    `json:"session_id"`
+	IPAddress string    `json:"ip_address" pii:"true"`
+	UserAgent string    `json:"user_agent" pii:"true"`
+	EnabledAt time.Time `json:"enabled_at"`
+}
+
+func (e LoginSucceeded) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("session_id", e.SessionID)
+	c.ip("ip_address", e.IPAddress)
+	c.requireTime("logged_in_at", e.LoggedInAt)
+	return c.err()
+}
+
+func (e LoginFailed) Validate() error {
+	var c fieldChecker
+	c.ip("ip_address", e.IPAddress)
+	c.requireString("reason", e.Reason)
+	c.requireTime("failed_at", e.FailedAt)
+	return c.err()
+}
+
+func (e NewDeviceLogin) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("session_id", e.SessionID)
+	c.ip("ip_address", e.IPAddress)
+	c.requireTime("logged_in_at", e.LoggedInAt)
+	return c.err()
+}
+
+func (e PasswordChanged) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.ip("ip_address", e.IPAddress)
+	c.requireTime("changed_at", e.ChangedAt)
+	return c.err()
+}
+
+func (e EmailChanged) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.email("new_email", e.NewEmail)
+	c.ip("ip_address", e.IPAddress)
+	c.requireTime("changed_at", e.ChangedAt)
+	return c.err()
+}
+
+func (e SessionRevoked) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("session_id", e.SessionID)
+	c.ip("ip_address", e.IPAddress)
+	c.requireString("reason", e.Reason)
+	c.requireTime("revoked_at", e.RevokedAt)
+	return c.err()
+}
+
+func (e MFAEnabled) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("method", e.Method)
+	c.ip("ip_address", e.IPAddress)
+	c.requireTime("enabled_at", e.EnabledAt)
+	return c.err()
+}
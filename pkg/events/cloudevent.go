@@ -0,0 +1,47 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const CloudEventsSpecVersion = "1.0"
+
+// CloudEvent is the CloudEvents 1.0 representation of an Event, as used by the
+// structured JSON format. Binary payloads travel in DataBase64
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Time            time.Time       `json:"time"`
+	Subject         string          `json:"subject,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	DataBase64      []byte          `json:"data_base64,omitempty"`
+}
+
+// NewCloudEvent maps an Event onto a CloudEvent with a fresh id. The subject is the
+// user the payload belongs to, when it has one
+func NewCloudEvent(source string, e Event) CloudEvent {
+	ce := CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              uuid.NewString(),
+		Source:          source,
+		Type:            e.Type,
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data:            e.Data,
+	}
+	if userID, ok := UserIDOf(e.Data); ok {
+		ce.Subject = userID.String()
+	}
+	return ce
+}
+
+// Event maps a CloudEvent with JSON data back onto an Event
+func (c CloudEvent) Event() Event {
+	return Event{Type: c.Type, Data: c.Data}
+}
@@ -0,0 +1,199 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventTypeCodeforcesAccountBound           = "codeforces.account.bound"
+	EventTypeCodeforcesAccountUnbound         = "codeforces.account.unbound"
+	EventTypeCodeforcesVerificationSucceeded  = "codeforces.verification.succeeded"
+	EventTypeCodeforcesVerificationFailed     = "codeforces.verification.failed"
+	EventTypeCodeforcesProfileUpdated         = "codeforces.profile.updated"
+	EventTypeCodeforcesHistoryImportCompleted = "codeforces.history.import.completed"
+	EventTypeCodeforcesHistoryImportFailed    = "codeforces.history.import.failed"
+	EventTypeCodeforcesCurrentYearRefreshed   = "codeforces.current-year.refreshed"
+	EventTypeCodeforcesTodayContributed       = "codeforces.today.contributed"
+)
+
+// ────────────────────────────────────────────────
+// Payload-structures of events
+// ────────────────────────────────────────────────
+
+type CodeforcesAccountBound struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodeforcesHandle string    `json:"codeforces_handle" pii:"true"`
+	BoundAt          time.Time `json:"bound_at"`
+	Verified         bool      `json:"verified"`
+}
+
+type CodeforcesAccountUnbound struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodeforcesHandle string    `json:"codeforces_handle,omitempty" pii:"true"`
+	UnboundAt        time.Time `json:"unbound_at"`
+	Reason           string    `json:"reason,omitempty"` // "manual", "verification_timeout", ...
+}
+
+type CodeforcesVerificationSucceeded struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodeforcesHandle string    `json:"codeforces_handle" pii:"true"`
+	VerifiedAt       time.Time `json:"verified_at"`
+}
+
+type CodeforcesVerificationFailed struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodeforcesHandle string    `json:"codeforces_handle,omitempty" pii:"true"`
+	FailedAt         time.Time `json:"failed_at"`
+	Reason           string    `json:"reason"` // "timeout", "token_not_found", ...
+	ErrorCode        string    `json:"error_code,omitempty"`
+}
+
+type CodeforcesProfileUpdated struct {
+	UserID               uuid.UUID `json:"user_id"`
+	CodeforcesHandle     string    `json:"codeforces_handle" pii:"true"`
+	Rating               int       `json:"rating"`
+	MaxRating            int       `json:"max_rating"`
+	Rank                 string    `json:"rank,omitempty"` // "newbie" … "legendary grandmaster", empty while unrated
+	MaxRank              string    `json:"max_rank,omitempty"`
+	ProblemsSolved       int       `json:"problems_solved"`
+	ContestsParticipated int       `json:"contests_participated"`
+	Verified             bool      `json:"verified"`
+	UpdatedAt            time.Time `json:"updated_at"`
+	ChangeReason         string    `json:"change_reason,omitempty"` // "initial_bind", "force_refresh", "background_sync"
+}
+
+type CodeforcesHistoryImportCompleted struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodeforcesHandle string    `json:"codeforces_handle" pii:"true"`
+	YearsImported    []int     `json:"years_imported"`
+	TotalYears       int       `json:"total_years"`
+	CompletedAt      time.Time `json:"completed_at"`
+}
+
+type CodeforcesHistoryImportFailed struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodeforcesHandle string    `json:"codeforces_handle" pii:"true"`
+	YearsAttempted   []int     `json:"years_attempted"`
+	Error            string    `json:"error"`
+	ErrorCode        string    `json:"error_code,omitempty"` // "rate_limit", "not_found", "timeout"
+	FailedAt         time.Time `json:"failed_at"`
+}
+
+type CodeforcesCurrentYearRefreshed struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodeforcesHandle string    `json:"codeforces_handle" pii:"true"`
+	Year             int       `json:"year"`
+	ProblemsSolved   int       `json:"problems_solved"`
+	ActiveDays       int       `json:"active_days,omitempty"`
+	RefreshedAt      time.Time `json:"refreshed_at"`
+}
+
+// CodeforcesTodayContributed
+// Fired when the problems accepted on the current day change. Count is the running total of the day
+type CodeforcesTodayContributed struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodeforcesHandle string    `json:"codeforces_handle" pii:"true"`
+	Count            int       `json:"count"`
+	Date             string    `json:"date"`               // local calendar date, "2006-01-02"
+	Timezone         string    `json:"timezone,omitempty"` // IANA timezone of the user
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// TodayContributed returns the source-independent event of the same day
+func (e CodeforcesTodayContributed) TodayContributed() TodayContributedEvent {
+	return TodayContributedEvent{
+		UserID:   e.UserID,
+		Source:   SourceCodeforces,
+		Count:    e.Count,
+		Date:     e.Date,
+		Timezone: e.Timezone,
+	}
+}
+
+// ────────────────────────────────────────────────
+// Validation
+// ────────────────────────────────────────────────
+
+func (e CodeforcesAccountBound) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codeforces_handle", e.CodeforcesHandle)
+	c.requireTime("bound_at", e.BoundAt)
+	return c.err()
+}
+
+func (e CodeforcesAccountUnbound) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireTime("unbound_at", e.UnboundAt)
+	return c.err()
+}
+
+func (e CodeforcesVerificationSucceeded) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codeforces_handle", e.CodeforcesHandle)
+	c.requireTime("verified_at", e.VerifiedAt)
+	return c.err()
+}
+
+func (e CodeforcesVerificationFailed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireTime("failed_at", e.FailedAt)
+	c.requireString("reason", e.Reason)
+	return c.err()
+}
+
+func (e CodeforcesProfileUpdated) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codeforces_handle", e.CodeforcesHandle)
+	c.nonNegative("max_rating", e.MaxRating)
+	c.nonNegative("problems_solved", e.ProblemsSolved)
+	c.nonNegative("contests_participated", e.ContestsParticipated)
+	c.requireTime("updated_at", e.UpdatedAt)
+	return c.err()
+}
+
+func (e CodeforcesHistoryImportCompleted) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codeforces_handle", e.CodeforcesHandle)
+	c.nonNegative("total_years", e.TotalYears)
+	c.requireTime("completed_at", e.CompletedAt)
+	return c.err()
+}
+
+func (e CodeforcesHistoryImportFailed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codeforces_handle", e.CodeforcesHandle)
+	c.requireString("error", e.Error)
+	c.requireTime("failed_at", e.FailedAt)
+	return c.err()
+}
+
+func (e CodeforcesCurrentYearRefreshed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codeforces_handle", e.CodeforcesHandle)
+	c.positive("year", e.Year)
+	c.nonNegative("problems_solved", e.ProblemsSolved)
+	c.nonNegative("active_days", e.ActiveDays)
+	c.requireTime("refreshed_at", e.RefreshedAt)
+	return c.err()
+}
+
+func (e CodeforcesTodayContributed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codeforces_handle", e.CodeforcesHandle)
+	c.nonNegative("count", e.Count)
+	c.requireDay("date", e.Date)
+	c.timezone("timezone", e.Timezone)
+	c.requireTime("updated_at", e.UpdatedAt)
+	return c.err()
+}
@@ -0,0 +1,200 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventTypeCodewarsAccountBound           = "codewars.account.bound"
+	EventTypeCodewarsAccountUnbound         = "codewars.account.unbound"
+	EventTypeCodewarsVerificationSucceeded  = "codewars.verification.succeeded"
+	EventTypeCodewarsVerificationFailed     = "codewars.verification.failed"
+	EventTypeCodewarsProfileUpdated         = "codewars.profile.updated"
+	EventTypeCodewarsHistoryImportCompleted = "codewars.history.import.completed"
+	EventTypeCodewarsHistoryImportFailed    = "codewars.history.import.failed"
+	EventTypeCodewarsCurrentYearRefreshed   = "codewars.current-year.refreshed"
+	EventTypeCodewarsTodayContributed       = "codewars.today.contributed"
+)
+
+// ────────────────────────────────────────────────
+// Payload-structures of events
+// ────────────────────────────────────────────────
+
+type CodewarsAccountBound struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodewarsUsername string    `json:"codewars_username" pii:"true"`
+	BoundAt          time.Time `json:"bound_at"`
+	Verified         bool      `json:"verified"`
+}
+
+type CodewarsAccountUnbound struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodewarsUsername string    `json:"codewars_username,omitempty" pii:"true"`
+	UnboundAt        time.Time `json:"unbound_at"`
+	Reason           string    `json:"reason,omitempty"` // "manual", "verification_timeout", ...
+}
+
+type CodewarsVerificationSucceeded struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodewarsUsername string    `json:"codewars_username" pii:"true"`
+	VerifiedAt       time.Time `json:"verified_at"`
+}
+
+type CodewarsVerificationFailed struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodewarsUsername string    `json:"codewars_username,omitempty" pii:"true"`
+	FailedAt         time.Time `json:"failed_at"`
+	Reason           string    `json:"reason"` // "timeout", "token_not_found", ...
+	ErrorCode        string    `json:"error_code,omitempty"`
+}
+
+type CodewarsProfileUpdated struct {
+	UserID              uuid.UUID `json:"user_id"`
+	CodewarsUsername    string    `json:"codewars_username" pii:"true"`
+	Honor               int       `json:"honor"`
+	Rank                string    `json:"rank"` // "8 kyu" … "1 dan"
+	Score               int       `json:"score"`
+	KatasCompleted      int       `json:"katas_completed"`
+	LeaderboardPosition int       `json:"leaderboard_position,omitempty"`
+	Verified            bool      `json:"verified"`
+	UpdatedAt           time.Time `json:"updated_at"`
+	ChangeReason        string    `json:"change_reason,omitempty"` // "initial_bind", "force_refresh", "background_sync"
+}
+
+type CodewarsHistoryImportCompleted struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodewarsUsername string    `json:"codewars_username" pii:"true"`
+	YearsImported    []int     `json:"years_imported"`
+	TotalYears       int       `json:"total_years"`
+	CompletedAt      time.Time `json:"completed_at"`
+}
+
+type CodewarsHistoryImportFailed struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodewarsUsername string    `json:"codewars_username" pii:"true"`
+	YearsAttempted   []int     `json:"years_attempted"`
+	Error            string    `json:"error"`
+	ErrorCode        string    `json:"error_code,omitempty"` // "rate_limit", "not_found", "timeout"
+	FailedAt         time.Time `json:"failed_at"`
+}
+
+type CodewarsCurrentYearRefreshed struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodewarsUsername string    `json:"codewars_username" pii:"true"`
+	Year             int       `json:"year"`
+	KatasCompleted   int       `json:"katas_completed"`
+	ActiveDays       int       `json:"active_days,omitempty"`
+	RefreshedAt      time.Time `json:"refreshed_at"`
+}
+
+// CodewarsTodayContributed
+// Fired when the kata completed on the current day change. Count is the running total of the day
+type CodewarsTodayContributed struct {
+	UserID           uuid.UUID `json:"user_id"`
+	CodewarsUsername string    `json:"codewars_username" pii:"true"`
+	Count            int       `json:"count"`
+	Date             string    `json:"date"`               // local calendar date, "2006-01-02"
+	Timezone         string    `json:"timezone,omitempty"` // IANA timezone of the user
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// TodayContributed returns the source-independent event of the same day
+func (e CodewarsTodayContributed) TodayContributed() TodayContributedEvent {
+	return TodayContributedEvent{
+		UserID:   e.UserID,
+		Source:   SourceCodewars,
+		Count:    e.Count,
+		Date:     e.Date,
+		Timezone: e.Timezone,
+	}
+}
+
+// ────────────────────────────────────────────────
+// Validation
+// ────────────────────────────────────────────────
+
+func (e CodewarsAccountBound) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codewars_username", e.CodewarsUsername)
+	c.requireTime("bound_at", e.BoundAt)
+	return c.err()
+}
+
+func (e CodewarsAccountUnbound) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireTime("unbound_at", e.UnboundAt)
+	return c.err()
+}
+
+func (e CodewarsVerificationSucceeded) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codewars_username", e.CodewarsUsername)
+	c.requireTime("verified_at", e.VerifiedAt)
+	return c.err()
+}
+
+func (e CodewarsVerificationFailed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireTime("failed_at", e.FailedAt)
+	c.requireString("reason", e.Reason)
+	return c.err()
+}
+
+func (e CodewarsProfileUpdated) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codewars_username", e.CodewarsUsername)
+	c.nonNegative("honor", e.Honor)
+	c.requireString("rank", e.Rank)
+	c.nonNegative("score", e.Score)
+	c.nonNegative("katas_completed", e.KatasCompleted)
+	c.nonNegative("leaderboard_position", e.LeaderboardPosition)
+	c.requireTime("updated_at", e.UpdatedAt)
+	return c.err()
+}
+
+func (e CodewarsHistoryImportCompleted) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codewars_username", e.CodewarsUsername)
+	c.nonNegative("total_years", e.TotalYears)
+	c.requireTime("completed_at", e.CompletedAt)
+	return c.err()
+}
+
+func (e CodewarsHistoryImportFailed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codewars_username", e.CodewarsUsername)
+	c.requireString("error", e.Error)
+	c.requireTime("failed_at", e.FailedAt)
+	return c.err()
+}
+
+func (e CodewarsCurrentYearRefreshed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codewars_username", e.CodewarsUsername)
+	c.positive("year", e.Year)
+	c.nonNegative("katas_completed", e.KatasCompleted)
+	c.nonNegative("active_days", e.ActiveDays)
+	c.requireTime("refreshed_at", e.RefreshedAt)
+	return c.err()
+}
+
+func (e CodewarsTodayContributed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("codewars_username", e.CodewarsUsername)
+	c.nonNegative("count", e.Count)
+	c.requireDay("date", e.Date)
+	c.timezone("timezone", e.Timezone)
+	c.requireTime("updated_at", e.UpdatedAt)
+	return c.err()
+}
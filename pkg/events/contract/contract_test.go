@@ -0,0 +1,31 @@
+package contract
+
+import "testing"
+
+// The tests run from the package directory, which holds fixtures/ and snapshot.json
+
+func TestFixtures(t *testing.T) {
+	for _, err := range CheckFixtures(".") {
+		t.Error(err)
+	}
+}
+
+func TestProto(t *testing.T) {
+	for _, err := range CheckProto(".") {
+		t.Error(err)
+	}
+}
+
+func TestNoBreakingChanges(t *testing.T) {
+	released, err := LoadSnapshot(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, change := range Compare(released, Current()) {
+		if change.Breaking {
+			t.Error(change)
+		} else {
+			t.Log(change)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/date"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+	"github.com/metacode-dream-team/MetaCode/pkg/events/schema"
+)
+
+var (
+	uuidType   = reflect.TypeOf(uuid.UUID{})
+	timeType   = reflect.TypeOf(time.Time{})
+	sourceType = reflect.TypeOf(events.Source(""))
+	tierType   = reflect.TypeOf(events.AchievementTier(""))
+	sampleTime = time.Date(2025, time.March, 14, 9, 26, 53, 0, time.UTC)
+)
+
+// FixturePath returns where the golden fixture of an event type is stored
+func FixturePath(dir, eventType string) string {
+	return filepath.Join(dir, "fixtures", eventType+".json")
+}
+
+// Sample builds a deterministic payload with every field populated, so that omitempty
+// fields show up in the fixture as well
+func Sample(d events.Descriptor) interface{} {
+	v := reflect.New(d.Payload).Elem()
+	fill(v, "")
+	return v.Interface()
+}
+
+func fill(v reflect.Value, name string) {
+	t := v.Type()
+	switch t {
+	case uuidType:
+		v.Set(reflect.ValueOf(uuid.NewSHA1(uuid.NameSpaceOID, []byte(name))))
+		return
+	case timeType:
+		v.Set(reflect.ValueOf(sampleTime))
+		return
+	case sourceType:
+		v.SetString(string(events.SourceGithub))
+		return
+	case tierType:
+		v.SetString(string(events.TierSilver))
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		v.Set(reflect.New(t.Elem()))
+		fill(v.Elem(), name)
+	case reflect.String:
+		v.SetString(sampleString(name))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(sampleInt(name))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(sampleInt(name)))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(97.5)
+	case reflect.Slice:
+		s := reflect.MakeSlice(t, 1, 1)
+		fill(s.Index(0), name)
+		v.Set(s)
+	case reflect.Map:
+		m := reflect.MakeMap(t)
+		key := reflect.New(t.Key()).Elem()
+		fill(key, name+"_key")
+		val := reflect.New(t.Elem()).Elem()
+		fill(val, name)
+		m.SetMapIndex(key, val)
+		v.Set(m)
+	case reflect.Struct:
+		for _, f := range schema.Fields(t) {
+			fill(v.FieldByName(f.GoName), f.Name)
+		}
+	}
+}
+
+func sampleString(name string) string {
+	switch {
+	case strings.Contains(name, "email"):
+		return "octocat@example.com"
+	case strings.HasSuffix(name, "url"):
+		return "https://cdn.metacode.dev/" + name
+	case name == "date" || strings.HasSuffix(name, "_date"):
+		return sampleTime.Format(date.DayLayout)
+	case name == "target_type":
+		return events.TargetComment
+	case name == "timezone":
+		return "Asia/Almaty"
+	case name == "ip_address":
+		return "203.0.113.7"
+	}
+	return "sample_" + name
+}
+
+func sampleInt(name string) int64 {
+	if strings.Contains(name, "year") && !strings.Contains(name, "years") {
+		return 2025
+	}
+	return 7
+}
+
+// WriteMissingFixtures creates fixtures for registered events that have none yet.
+// Existing fixtures are golden and never overwritten
+func WriteMissingFixtures(dir string) ([]string, error) {
+	if err := os.MkdirAll(filepath.Join(dir, "fixtures"), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create fixtures directory: %w", err)
+	}
+
+	var written []string
+	for _, d := range events.Registered() {
+		path := FixturePath(dir, d.Type)
+		if _, err := os.Stat(path); err == nil {
+			continue
+		}
+
+		data, err := json.MarshalIndent(Sample(d), "", "  ")
+		if err != nil {
+			return written, fmt.Errorf("failed to encode %s fixture: %w", d.Type, err)
+		}
+		if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+			return written, fmt.Errorf("failed to write %s fixture: %w", d.Type, err)
+		}
+		written = append(written, path)
+	}
+	return written, nil
+}
+
+// RoundTrip decodes a golden fixture into the current payload struct and encodes it back.
+// It fails when a fixture field is unknown to the struct (renamed or removed tag), when a
+// value no longer decodes (changed type) or when the re-encoded JSON differs
+func RoundTrip(d events.Descriptor, fixture []byte) error {
+	payload := d.New()
+
+	dec := json.NewDecoder(bytes.NewReader(fixture))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(payload); err != nil {
+		return fmt.Errorf("decode fixture: %w", err)
+	}
+	if err := events.Validate(payload); err != nil {
+		return fmt.Errorf("fixture is not a valid payload: %w", err)
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encode payload: %w", err)
+	}
+
+	var want, got interface{}
+	if err := json.Unmarshal(fixture, &want); err != nil {
+		return fmt.Errorf("parse fixture: %w", err)
+	}
+	if err := json.Unmarshal(encoded, &got); err != nil {
+		return fmt.Errorf("parse encoded payload: %w", err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		return fmt.Errorf("round trip mismatch:\n  fixture: %s\n  encoded: %s", compact(fixture), encoded)
+	}
+	return nil
+}
+
+// CheckFixtures round-trips the fixture of every registered event
+func CheckFixtures(dir string) []error {
+	var errs []error
+	for _, d := range events.Registered() {
+		fixture, err := os.ReadFile(FixturePath(dir, d.Type))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: missing golden fixture: %w", d.Type, err))
+			continue
+		}
+		if err := RoundTrip(d, fixture); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", d.Type, err))
+		}
+	}
+	return errs
+}
+
+func compact(data []byte) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return string(data)
+	}
+	return buf.String()
+}
@@ -0,0 +1,64 @@
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+	_ "github.com/metacode-dream-team/MetaCode/pkg/pb/events"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// protoPackage must match the package of proto/events/*.proto
+const protoPackage = "metacode.events.v1"
+
+// ProtoRoundTrip converts a golden fixture into the protobuf message of the event and back.
+// It fails when the message is missing, lacks a field or maps a field onto another type
+func ProtoRoundTrip(d events.Descriptor, fixture []byte) error {
+	name := protoreflect.FullName(protoPackage + "." + d.Payload.Name())
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return fmt.Errorf("missing protobuf message %s", name)
+	}
+
+	msg := mt.New().Interface()
+	if err := protojson.Unmarshal(fixture, msg); err != nil {
+		return fmt.Errorf("convert fixture to %s: %w", name, err)
+	}
+
+	back, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("convert %s to JSON: %w", name, err)
+	}
+
+	want, got := d.New(), d.New()
+	if err := json.Unmarshal(fixture, want); err != nil {
+		return fmt.Errorf("decode fixture: %w", err)
+	}
+	if err := json.Unmarshal(back, got); err != nil {
+		return fmt.Errorf("decode %s JSON: %w", name, err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		return fmt.Errorf("protobuf round trip mismatch:\n  fixture: %s\n  decoded: %s", compact(fixture), back)
+	}
+	return nil
+}
+
+// CheckProto round-trips the fixture of every registered event through protobuf
+func CheckProto(dir string) []error {
+	var errs []error
+	for _, d := range events.Registered() {
+		fixture, err := os.ReadFile(FixturePath(dir, d.Type))
+		if err != nil {
+			continue // reported by CheckFixtures
+		}
+		if err := ProtoRoundTrip(d, fixture); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", d.Type, err))
+		}
+	}
+	return errs
+}
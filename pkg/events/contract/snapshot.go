@@ -0,0 +1,185 @@
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+	"github.com/metacode-dream-team/MetaCode/pkg/events/schema"
+)
+
+// Snapshot records the wire shape of every event payload: event type → JSON field → type
+type Snapshot map[string]map[string]FieldShape
+
+type FieldShape struct {
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+}
+
+// Change is a difference between two snapshots
+type Change struct {
+	EventType string
+	Field     string
+	Breaking  bool
+	Message   string
+}
+
+func (c Change) String() string {
+	kind := "compatible"
+	if c.Breaking {
+		kind = "BREAKING"
+	}
+	if c.Field == "" {
+		return fmt.Sprintf("[%s] %s: %s", kind, c.EventType, c.Message)
+	}
+	return fmt.Sprintf("[%s] %s.%s: %s", kind, c.EventType, c.Field, c.Message)
+}
+
+// SnapshotPath returns where the last released snapshot is stored
+func SnapshotPath(dir string) string {
+	return filepath.Join(dir, "snapshot.json")
+}
+
+// Current takes a snapshot of the registered payload structs
+func Current() Snapshot {
+	snap := Snapshot{}
+	for _, d := range events.Registered() {
+		fields := map[string]FieldShape{}
+		for _, f := range schema.Fields(d.Payload) {
+			fields[f.Name] = FieldShape{
+				Type:     typeName(f.Type),
+				Required: !f.OmitEmpty && f.Type.Kind() != reflect.Ptr,
+			}
+		}
+		snap[d.Type] = fields
+	}
+	return snap
+}
+
+// typeName describes a Go type by its JSON Schema shape, e.g. "string:uuid" or "array<integer>"
+func typeName(t reflect.Type) string {
+	return shapeName(schema.ForType(t))
+}
+
+func shapeName(s schema.Schema) string {
+	var name string
+	switch typ := s["type"].(type) {
+	case string:
+		name = typ
+	case []string:
+		name = typ[0]
+	default:
+		return "any"
+	}
+
+	switch name {
+	case "string":
+		if format, ok := s["format"].(string); ok {
+			name += ":" + format
+		}
+	case "array":
+		if items, ok := s["items"].(schema.Schema); ok {
+			name += "<" + shapeName(items) + ">"
+		}
+	case "object":
+		if props, ok := s["properties"].(schema.Schema); ok {
+			keys := make([]string, 0, len(props))
+			for k := range props {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			parts := make([]string, len(keys))
+			for i, k := range keys {
+				parts[i] = k + ":" + shapeName(props[k].(schema.Schema))
+			}
+			name += "{" + strings.Join(parts, ",") + "}"
+		}
+	}
+	return name
+}
+
+// Compare lists the changes from the released snapshot to the current one. Removed
+// events or fields, changed types and fields that became required are breaking;
+// a renamed tag shows up as a removed field
+func Compare(released, current Snapshot) []Change {
+	var changes []Change
+
+	for _, eventType := range sortedKeys(released) {
+		oldFields := released[eventType]
+		newFields, ok := current[eventType]
+		if !ok {
+			changes = append(changes, Change{EventType: eventType, Breaking: true, Message: "event type removed"})
+			continue
+		}
+
+		for _, name := range sortedKeys(oldFields) {
+			was := oldFields[name]
+			now, ok := newFields[name]
+			switch {
+			case !ok:
+				changes = append(changes, Change{eventType, name, true, "field removed or its json tag renamed"})
+			case was.Type != now.Type:
+				changes = append(changes, Change{eventType, name, true, fmt.Sprintf("type changed from %s to %s", was.Type, now.Type)})
+			case !was.Required && now.Required:
+				changes = append(changes, Change{eventType, name, true, "optional field became required"})
+			case was.Required && !now.Required:
+				changes = append(changes, Change{eventType, name, false, "required field became optional"})
+			}
+		}
+
+		for _, name := range sortedKeys(newFields) {
+			if _, ok := oldFields[name]; ok {
+				continue
+			}
+			changes = append(changes, Change{eventType, name, newFields[name].Required, "field added"})
+		}
+	}
+
+	for _, eventType := range sortedKeys(current) {
+		if _, ok := released[eventType]; !ok {
+			changes = append(changes, Change{EventType: eventType, Message: "event type added"})
+		}
+	}
+
+	return changes
+}
+
+// LoadSnapshot reads the released snapshot
+func LoadSnapshot(dir string) (Snapshot, error) {
+	data, err := os.ReadFile(SnapshotPath(dir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	return snap, nil
+}
+
+// WriteSnapshot stores the snapshot as the new released baseline
+func WriteSnapshot(dir string, snap Snapshot) error {
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := os.WriteFile(SnapshotPath(dir), append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,7 +1,10 @@
 package events
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/date"
 )
 
 type Source string
@@ -11,10 +14,72 @@ const (
 	SourceGithub              Source = "github"
 	SourceLeetcode            Source = "leetcode"
 	SourceMonkeytype          Source = "monkeytype"
+	SourceCodeforces          Source = "codeforces"
+	SourceCodewars            Source = "codewars"
 )
 
+var knownSources = []Source{SourceGithub, SourceLeetcode, SourceMonkeytype, SourceCodeforces, SourceCodewars}
+
+// Sources returns every known integration source
+func Sources() []Source {
+	return append([]Source(nil), knownSources...)
+}
+
+// Valid reports whether the source is one of the known integrations
+func (s Source) Valid() bool {
+	for _, known := range knownSources {
+		if s == known {
+			return true
+		}
+	}
+	return false
+}
+
+// TodayContributedEvent reports the contributions of a user on one local calendar day.
+// Date and Timezone are empty on events produced before they were introduced
 type TodayContributedEvent struct {
-	UserID uuid.UUID `json:"user_id"`
-	Source Source    `json:"source"`
-	Count  int       `json:"count"`
+	UserID   uuid.UUID `json:"user_id"`
+	Source   Source    `json:"source"`
+	Count    int       `json:"count"`
+	Date     string    `json:"date,omitempty"`     // local calendar date, "2006-01-02"
+	Timezone string    `json:"timezone,omitempty"` // IANA timezone of the user, e.g. "Asia/Almaty"
+}
+
+// NewTodayContributed builds the event for the local day of the user containing at
+func NewTodayContributed(userID uuid.UUID, source Source, count int, at time.Time, timezone string) (TodayContributedEvent, error) {
+	loc, err := date.LoadLocation(timezone)
+	if err != nil {
+		return TodayContributedEvent{}, err
+	}
+	return TodayContributedEvent{
+		UserID:   userID,
+		Source:   source,
+		Count:    count,
+		Date:     date.Day(at, loc),
+		Timezone: loc.String(),
+	}, nil
+}
+
+// DayBounds returns the start and end of the day the contributions belong to. Events
+// without a date fall back to the UTC day containing fallback, usually the receive time
+func (e TodayContributedEvent) DayBounds(fallback time.Time) (start, end time.Time, err error) {
+	loc, err := date.LoadLocation(e.Timezone)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if e.Date == "" {
+		start, end = date.DayBounds(fallback, loc)
+		return start, end, nil
+	}
+	return date.ParseDay(e.Date, loc)
+}
+
+func (e TodayContributedEvent) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.source("source", e.Source)
+	c.nonNegative("count", e.Count)
+	c.day("date", e.Date)
+	c.timezone("timezone", e.Timezone)
+	return c.err()
 }
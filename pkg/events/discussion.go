@@ -7,12 +7,196 @@ import (
 )
 
 const (
-	EventTypeDiscussionCreated = "discussion.created"
+	EventTypeDiscussionCreated       = "discussion.created"
+	EventTypeDiscussionUpdated       = "discussion.updated"
+	EventTypeDiscussionDeleted       = "discussion.deleted"
+	EventTypeCommentCreated          = "discussion.comment.created"
+	EventTypeCommentDeleted          = "discussion.comment.deleted"
+	EventTypeCommentReplied          = "discussion.comment.replied"
+	EventTypeReactionAdded           = "discussion.reaction.added"
+	EventTypeReactionRemoved         = "discussion.reaction.removed"
+	EventTypeDiscussionUserMentioned = "discussion.mention.created"
 )
 
+// Reactions and mentions point at a discussion or a comment
+const (
+	TargetDiscussion = "discussion"
+	TargetComment    = "comment"
+)
+
+// In social events AuthorID is the user who acted and TargetUserID the user the action
+// is aimed at: the owner of the discussion or comment, or the mentioned user. Both are
+// equal when users act on their own content
+
 type DiscussionCreated struct {
 	ID         uuid.UUID `json:"id"`
 	AuthorID   uuid.UUID `json:"author_id"`
 	PreviewURL *string   `json:"preview_url"`
 	CreatedAt  time.Time `json:"created_at"`
 }
+
+type DiscussionUpdated struct {
+	ID           uuid.UUID `json:"id"`
+	AuthorID     uuid.UUID `json:"author_id"`
+	TargetUserID uuid.UUID `json:"target_user_id"`
+	PreviewURL   *string   `json:"preview_url"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type DiscussionDeleted struct {
+	ID           uuid.UUID `json:"id"`
+	AuthorID     uuid.UUID `json:"author_id"`
+	TargetUserID uuid.UUID `json:"target_user_id"`
+	Reason       string    `json:"reason,omitempty"` // "author", "moderation"
+	DeletedAt    time.Time `json:"deleted_at"`
+}
+
+type CommentCreated struct {
+	ID           uuid.UUID `json:"id"`
+	DiscussionID uuid.UUID `json:"discussion_id"`
+	AuthorID     uuid.UUID `json:"author_id"`
+	TargetUserID uuid.UUID `json:"target_user_id"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type CommentDeleted struct {
+	ID           uuid.UUID `json:"id"`
+	DiscussionID uuid.UUID `json:"discussion_id"`
+	AuthorID     uuid.UUID `json:"author_id"`
+	TargetUserID uuid.UUID `json:"target_user_id"`
+	Reason       string    `json:"reason,omitempty"` // "author", "moderation"
+	DeletedAt    time.Time `json:"deleted_at"`
+}
+
+// CommentReplied is emitted for a comment answering another comment; TargetUserID is
+// the author of the parent comment
+type CommentReplied struct {
+	ID              uuid.UUID `json:"id"`
+	DiscussionID    uuid.UUID `json:"discussion_id"`
+	ParentCommentID uuid.UUID `json:"parent_comment_id"`
+	AuthorID        uuid.UUID `json:"author_id"`
+	TargetUserID    uuid.UUID `json:"target_user_id"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type ReactionAdded struct {
+	DiscussionID uuid.UUID `json:"discussion_id"`
+	TargetType   string    `json:"target_type"` // TargetDiscussion or TargetComment
+	TargetID     uuid.UUID `json:"target_id"`
+	AuthorID     uuid.UUID `json:"author_id"`
+	TargetUserID uuid.UUID `json:"target_user_id"`
+	Reaction     string    `json:"reaction"` // "like", "fire", ...
+	AddedAt      time.Time `json:"added_at"`
+}
+
+type ReactionRemoved struct {
+	DiscussionID uuid.UUID `json:"discussion_id"`
+	TargetType   string    `json:"target_type"`
+	TargetID     uuid.UUID `json:"target_id"`
+	AuthorID     uuid.UUID `json:"author_id"`
+	TargetUserID uuid.UUID `json:"target_user_id"`
+	Reaction     string    `json:"reaction"`
+	RemovedAt    time.Time `json:"removed_at"`
+}
+
+// DiscussionUserMentioned is emitted per mentioned user of a discussion or comment
+type DiscussionUserMentioned struct {
+	DiscussionID uuid.UUID `json:"discussion_id"`
+	TargetType   string    `json:"target_type"`
+	TargetID     uuid.UUID `json:"target_id"`
+	AuthorID     uuid.UUID `json:"author_id"`
+	TargetUserID uuid.UUID `json:"target_user_id"`
+	MentionedAt  time.Time `json:"mentioned_at"`
+}
+
+func (e DiscussionCreated) Validate() error {
+	var c fieldChecker
+	c.requireUUID("id", e.ID)
+	c.requireUUID("author_id", e.AuthorID)
+	c.requireTime("created_at", e.CreatedAt)
+	return c.err()
+}
+
+func (e DiscussionUpdated) Validate() error {
+	var c fieldChecker
+	c.requireUUID("id", e.ID)
+	c.requireUUID("author_id", e.AuthorID)
+	c.requireUUID("target_user_id", e.TargetUserID)
+	c.requireTime("updated_at", e.UpdatedAt)
+	return c.err()
+}
+
+func (e DiscussionDeleted) Validate() error {
+	var c fieldChecker
+	c.requireUUID("id", e.ID)
+	c.requireUUID("author_id", e.AuthorID)
+	c.requireUUID("target_user_id", e.TargetUserID)
+	c.requireTime("deleted_at", e.DeletedAt)
+	return c.err()
+}
+
+func (e CommentCreated) Validate() error {
+	var c fieldChecker
+	c.requireUUID("id", e.ID)
+	c.requireUUID("discussion_id", e.DiscussionID)
+	c.requireUUID("author_id", e.AuthorID)
+	c.requireUUID("target_user_id", e.TargetUserID)
+	c.requireTime("created_at", e.CreatedAt)
+	return c.err()
+}
+
+func (e CommentDeleted) Validate() error {
+	var c fieldChecker
+	c.requireUUID("id", e.ID)
+	c.requireUUID("discussion_id", e.DiscussionID)
+	c.requireUUID("author_id", e.AuthorID)
+	c.requireUUID("target_user_id", e.TargetUserID)
+	c.requireTime("deleted_at", e.DeletedAt)
+	return c.err()
+}
+
+func (e CommentReplied) Validate() error {
+	var c fieldChecker
+	c.requireUUID("id", e.ID)
+	c.requireUUID("discussion_id", e.DiscussionID)
+	c.requireUUID("parent_comment_id", e.ParentCommentID)
+	c.requireUUID("author_id", e.AuthorID)
+	c.requireUUID("target_user_id", e.TargetUserID)
+	c.requireTime("created_at", e.CreatedAt)
+	return c.err()
+}
+
+func (e ReactionAdded) Validate() error {
+	var c fieldChecker
+	c.requireUUID("discussion_id", e.DiscussionID)
+	c.target("target_type", e.TargetType)
+	c.requireUUID("target_id", e.TargetID)
+	c.requireUUID("author_id", e.AuthorID)
+	c.requireUUID("target_user_id", e.TargetUserID)
+	c.requireString("reaction", e.Reaction)
+	c.requireTime("added_at", e.AddedAt)
+	return c.err()
+}
+
+func (e ReactionRemoved) Validate() error {
+	var c fieldChecker
+	c.requireUUID("discussion_id", e.DiscussionID)
+	c.target("target_type", e.TargetType)
+	c.requireUUID("target_id", e.TargetID)
+	c.requireUUID("author_id", e.AuthorID)
+	c.requireUUID("target_user_id", e.TargetUserID)
+	c.requireString("reaction", e.Reaction)
+	c.requireTime("removed_at", e.RemovedAt)
+	return c.err()
+}
+
+func (e DiscussionUserMentioned) Validate() error {
+	var c fieldChecker
+	c.requireUUID("discussion_id", e.DiscussionID)
+	c.target("target_type", e.TargetType)
+	c.requireUUID("target_id", e.TargetID)
+	c.requireUUID("author_id", e.AuthorID)
+	c.requireUUID("target_user_id", e.TargetUserID)
+	c.requireTime("mentioned_at", e.MentionedAt)
+	return c.err()
+}
@@ -1,8 +1,55 @@
 package events
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/google/uuid"
+)
 
 type Event struct {
 	Type string          `json:"type"`
 	Data json.RawMessage `json:"data"`
 }
+
+var uuidType = reflect.TypeOf(uuid.UUID{})
+
+// UserIDOf returns the user a payload belongs to: the UserID field of a payload struct,
+// or the user_id field of an encoded JSON payload
+func UserIDOf(payload interface{}) (uuid.UUID, bool) {
+	switch p := payload.(type) {
+	case json.RawMessage:
+		return userIDOfJSON(p)
+	case []byte:
+		return userIDOfJSON(p)
+	}
+
+	v := reflect.ValueOf(payload)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return uuid.Nil, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return uuid.Nil, false
+	}
+
+	f := v.FieldByName("UserID")
+	if !f.IsValid() || f.Type() != uuidType {
+		return uuid.Nil, false
+	}
+
+	id := f.Interface().(uuid.UUID)
+	return id, id != uuid.Nil
+}
+
+func userIDOfJSON(data []byte) (uuid.UUID, bool) {
+	var p struct {
+		UserID uuid.UUID `json:"user_id"`
+	}
+	if err := json.Unmarshal(data, &p); err != nil {
+		return uuid.Nil, false
+	}
+	return p.UserID, p.UserID != uuid.Nil
+}
@@ -18,3 +18,19 @@ type AvatarProcessingFinishedEvent struct {
 	S3MediumUrl string    `json:"s3_medium_url"`
 	S3LargeUrl  string    `json:"s3_large_url"`
 }
+
+func (e AvatarUpdatedEvent) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("s3_original_url", e.S3OriginalUrl)
+	return c.err()
+}
+
+func (e AvatarProcessingFinishedEvent) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("s3_small_url", e.S3SmallUrl)
+	c.requireString("s3_medium_url", e.S3MediumUrl)
+	c.requireString("s3_large_url", e.S3LargeUrl)
+	return c.err()
+}
@@ -0,0 +1,45 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventTypeUserFollowed   = "user.followed"
+	EventTypeUserUnfollowed = "user.unfollowed"
+)
+
+// UserFollowed is emitted when FollowerID starts following FolloweeID, who is the user
+// to notify
+type UserFollowed struct {
+	FollowerID uuid.UUID `json:"follower_id"`
+	FolloweeID uuid.UUID `json:"followee_id"`
+	Mutual     bool      `json:"mutual"` // the followee already follows back
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+type UserUnfollowed struct {
+	FollowerID   uuid.UUID `json:"follower_id"`
+	FolloweeID   uuid.UUID `json:"followee_id"`
+	UnfollowedAt time.Time `json:"unfollowed_at"`
+}
+
+func (e UserFollowed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("follower_id", e.FollowerID)
+	c.requireUUID("followee_id", e.FolloweeID)
+	c.distinct("followee_id", e.FollowerID, e.FolloweeID)
+	c.requireTime("followed_at", e.FollowedAt)
+	return c.err()
+}
+
+func (e UserUnfollowed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("follower_id", e.FollowerID)
+	c.requireUUID("followee_id", e.FolloweeID)
+	c.distinct("followee_id", e.FollowerID, e.FolloweeID)
+	c.requireTime("unfollowed_at", e.UnfollowedAt)
+	return c.err()
+}
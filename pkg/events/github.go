@@ -21,19 +21,19 @@ const (
 type GitHubAccountLinked struct {
 	UserID         uuid.UUID `json:"user_id"`
 	GitHubUserID   string    `json:"github_user_id"`
-	GitHubUsername string    `json:"github_username"`
+	GitHubUsername string    `json:"github_username" pii:"true"`
 	LinkedAt       time.Time `json:"linked_at"`
 }
 
 type GitHubAccountUnlinked struct {
 	UserID         uuid.UUID `json:"user_id"`
-	GitHubUsername string    `json:"github_username,omitempty"`
+	GitHubUsername string    `json:"github_username,omitempty" pii:"true"`
 	UnlinkedAt     time.Time `json:"unlinked_at"`
 }
 
 type GitHubProfileUpdated struct {
 	UserID             uuid.UUID `json:"user_id"`
-	GitHubUsername     string    `json:"github_username"`
+	GitHubUsername     string    `json:"github_username" pii:"true"`
 	TotalContributions int       `json:"total_contributions"`
 	CurrentYear        int       `json:"current_year"`
 	UpdatedAt          time.Time `json:"updated_at"`
@@ -43,7 +43,7 @@ type GitHubProfileUpdated struct {
 
 type GitHubHistoryImportCompleted struct {
 	UserID         uuid.UUID `json:"user_id"`
-	GitHubUsername string    `json:"github_username"`
+	GitHubUsername string    `json:"github_username" pii:"true"`
 	YearsImported  []int     `json:"years_imported"`
 	TotalYears     int       `json:"total_years"`
 	CompletedAt    time.Time `json:"completed_at"`
@@ -51,7 +51,7 @@ type GitHubHistoryImportCompleted struct {
 
 type GitHubHistoryImportFailed struct {
 	UserID         uuid.UUID `json:"user_id"`
-	GitHubUsername string    `json:"github_username"`
+	GitHubUsername string    `json:"github_username" pii:"true"`
 	YearsAttempted []int     `json:"years_attempted"`
 	Error          string    `json:"error"`
 	ErrorCode      string    `json:"error_code,omitempty"` // "rate_limit", "unauthorized", "timeout"...
@@ -60,9 +60,68 @@ type GitHubHistoryImportFailed struct {
 
 type GitHubCurrentYearRefreshed struct {
 	UserID             uuid.UUID `json:"user_id"`
-	GitHubUsername     string    `json:"github_username"`
+	GitHubUsername     string    `json:"github_username" pii:"true"`
 	Year               int       `json:"year"`
 	TotalContributions int       `json:"total_contributions"`
 	DaysWithActivity   int       `json:"days_with_activity,omitempty"`
 	RefreshedAt        time.Time `json:"refreshed_at"`
 }
+
+// ────────────────────────────────────────────────
+// Validation
+// ────────────────────────────────────────────────
+
+func (e GitHubAccountLinked) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("github_user_id", e.GitHubUserID)
+	c.requireString("github_username", e.GitHubUsername)
+	c.requireTime("linked_at", e.LinkedAt)
+	return c.err()
+}
+
+func (e GitHubAccountUnlinked) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireTime("unlinked_at", e.UnlinkedAt)
+	return c.err()
+}
+
+func (e GitHubProfileUpdated) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("github_username", e.GitHubUsername)
+	c.nonNegative("total_contributions", e.TotalContributions)
+	c.positive("current_year", e.CurrentYear)
+	c.requireTime("updated_at", e.UpdatedAt)
+	return c.err()
+}
+
+func (e GitHubHistoryImportCompleted) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("github_username", e.GitHubUsername)
+	c.nonNegative("total_years", e.TotalYears)
+	c.requireTime("completed_at", e.CompletedAt)
+	return c.err()
+}
+
+func (e GitHubHistoryImportFailed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("github_username", e.GitHubUsername)
+	c.requireString("error", e.Error)
+	c.requireTime("failed_at", e.FailedAt)
+	return c.err()
+}
+
+func (e GitHubCurrentYearRefreshed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("github_username", e.GitHubUsername)
+	c.positive("year", e.Year)
+	c.nonNegative("total_contributions", e.TotalContributions)
+	c.nonNegative("days_with_activity", e.DaysWithActivity)
+	c.requireTime("refreshed_at", e.RefreshedAt)
+	return c.err()
+}
@@ -0,0 +1,263 @@
+package events
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventTypeIntegrationAccountLinked         = "integration.account.linked"
+	EventTypeIntegrationAccountUnlinked       = "integration.account.unlinked"
+	EventTypeIntegrationVerificationSucceeded = "integration.account.verification.succeeded"
+	EventTypeIntegrationVerificationFailed    = "integration.account.verification.failed"
+)
+
+// Integration account events are the same for every source and replace the per-source
+// bound, unbound and verification events. ExternalID is the stable account id at the
+// source when it has one (GitHub), otherwise the username identifies the account
+
+type IntegrationAccountLinked struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Source     Source    `json:"source"`
+	ExternalID string    `json:"external_id,omitempty"`
+	Username   string    `json:"username" pii:"true"`
+	Verified   bool      `json:"verified"`
+	LinkedAt   time.Time `json:"linked_at"`
+}
+
+type IntegrationAccountUnlinked struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Source     Source    `json:"source"`
+	ExternalID string    `json:"external_id,omitempty"`
+	Username   string    `json:"username,omitempty" pii:"true"`
+	Reason     string    `json:"reason,omitempty"` // "manual", "verification_timeout", ...
+	UnlinkedAt time.Time `json:"unlinked_at"`
+}
+
+type IntegrationVerificationSucceeded struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Source     Source    `json:"source"`
+	ExternalID string    `json:"external_id,omitempty"`
+	Username   string    `json:"username" pii:"true"`
+	VerifiedAt time.Time `json:"verified_at"`
+}
+
+type IntegrationVerificationFailed struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Source     Source    `json:"source"`
+	ExternalID string    `json:"external_id,omitempty"`
+	Username   string    `json:"username,omitempty" pii:"true"`
+	Reason     string    `json:"reason"` // "timeout", "token_not_found", ...
+	ErrorCode  string    `json:"error_code,omitempty"`
+	FailedAt   time.Time `json:"failed_at"`
+}
+
+// ────────────────────────────────────────────────
+// Migration from per-source events
+// ────────────────────────────────────────────────
+
+// accountEventTypes are the event types converted by UnifiedAccountEvent and LegacyAccountEvent
+var accountEventTypes = map[string]bool{
+	EventTypeGitHubAccountLinked:              true,
+	EventTypeGitHubAccountUnlinked:            true,
+	EventTypeLeetCodeAccountBound:             true,
+	EventTypeLeetCodeAccountUnbound:           true,
+	EventTypeLeetCodeVerificationSucceeded:    true,
+	EventTypeLeetCodeVerificationFailed:       true,
+	EventTypeMonkeytypeAccountBound:           true,
+	EventTypeMonkeytypeAccountUnbound:         true,
+	EventTypeMonkeytypeVerificationSucceeded:  true,
+	EventTypeMonkeytypeVerificationFailed:     true,
+	EventTypeCodeforcesAccountBound:           true,
+	EventTypeCodeforcesAccountUnbound:         true,
+	EventTypeCodeforcesVerificationSucceeded:  true,
+	EventTypeCodeforcesVerificationFailed:     true,
+	EventTypeCodewarsAccountBound:             true,
+	EventTypeCodewarsAccountUnbound:           true,
+	EventTypeCodewarsVerificationSucceeded:    true,
+	EventTypeCodewarsVerificationFailed:       true,
+	EventTypeIntegrationAccountLinked:         true,
+	EventTypeIntegrationAccountUnlinked:       true,
+	EventTypeIntegrationVerificationSucceeded: true,
+	EventTypeIntegrationVerificationFailed:    true,
+}
+
+// IsAccountEvent reports whether events of the type may have a counterpart during the migration
+func IsAccountEvent(eventType string) bool {
+	return accountEventTypes[eventType]
+}
+
+// UnifiedAccountEvent returns the integration account event matching a per-source
+// account event, given as struct or pointer. ok is false for payloads without a counterpart
+func UnifiedAccountEvent(payload interface{}) (eventType string, unified interface{}, ok bool) {
+	switch e := deref(payload).(type) {
+	// GitHub accounts are linked through OAuth and need no verification
+	case GitHubAccountLinked:
+		return EventTypeIntegrationAccountLinked, IntegrationAccountLinked{UserID: e.UserID, Source: SourceGithub, ExternalID: e.GitHubUserID, Username: e.GitHubUsername, Verified: true, LinkedAt: e.LinkedAt}, true
+	case GitHubAccountUnlinked:
+		return EventTypeIntegrationAccountUnlinked, IntegrationAccountUnlinked{UserID: e.UserID, Source: SourceGithub, Username: e.GitHubUsername, UnlinkedAt: e.UnlinkedAt}, true
+
+	case LeetCodeAccountBound:
+		return linked(e.UserID, SourceLeetcode, e.LeetCodeUsername, e.Verified, e.BoundAt)
+	case LeetCodeAccountUnbound:
+		return unlinked(e.UserID, SourceLeetcode, e.LeetCodeUsername, e.Reason, e.UnboundAt)
+	case LeetCodeVerificationSucceeded:
+		return verified(e.UserID, SourceLeetcode, e.LeetCodeUsername, e.VerifiedAt)
+	case LeetCodeVerificationFailed:
+		return verificationFailed(e.UserID, SourceLeetcode, e.LeetCodeUsername, e.Reason, e.ErrorCode, e.FailedAt)
+
+	case MonkeytypeAccountBound:
+		return linked(e.UserID, SourceMonkeytype, e.MonkeytypeUsername, e.Verified, e.BoundAt)
+	case MonkeytypeAccountUnbound:
+		return unlinked(e.UserID, SourceMonkeytype, e.MonkeytypeUsername, e.Reason, e.UnboundAt)
+	case MonkeytypeVerificationSucceeded:
+		return verified(e.UserID, SourceMonkeytype, e.MonkeytypeUsername, e.VerifiedAt)
+	case MonkeytypeVerificationFailed:
+		return verificationFailed(e.UserID, SourceMonkeytype, e.MonkeytypeUsername, e.Reason, e.ErrorCode, e.FailedAt)
+
+	case CodeforcesAccountBound:
+		return linked(e.UserID, SourceCodeforces, e.CodeforcesHandle, e.Verified, e.BoundAt)
+	case CodeforcesAccountUnbound:
+		return unlinked(e.UserID, SourceCodeforces, e.CodeforcesHandle, e.Reason, e.UnboundAt)
+	case CodeforcesVerificationSucceeded:
+		return verified(e.UserID, SourceCodeforces, e.CodeforcesHandle, e.VerifiedAt)
+	case CodeforcesVerificationFailed:
+		return verificationFailed(e.UserID, SourceCodeforces, e.CodeforcesHandle, e.Reason, e.ErrorCode, e.FailedAt)
+
+	case CodewarsAccountBound:
+		return linked(e.UserID, SourceCodewars, e.CodewarsUsername, e.Verified, e.BoundAt)
+	case CodewarsAccountUnbound:
+		return unlinked(e.UserID, SourceCodewars, e.CodewarsUsername, e.Reason, e.UnboundAt)
+	case CodewarsVerificationSucceeded:
+		return verified(e.UserID, SourceCodewars, e.CodewarsUsername, e.VerifiedAt)
+	case CodewarsVerificationFailed:
+		return verificationFailed(e.UserID, SourceCodewars, e.CodewarsUsername, e.Reason, e.ErrorCode, e.FailedAt)
+	}
+	return "", nil, false
+}
+
+// LegacyAccountEvent returns the per-source event matching an integration account event,
+// for consumers not migrated yet. ok is false when the source has no such event, e.g.
+// GitHub verification
+func LegacyAccountEvent(payload interface{}) (eventType string, legacy interface{}, ok bool) {
+	switch e := deref(payload).(type) {
+	case IntegrationAccountLinked:
+		switch e.Source {
+		case SourceGithub:
+			return EventTypeGitHubAccountLinked, GitHubAccountLinked{UserID: e.UserID, GitHubUserID: e.ExternalID, GitHubUsername: e.Username, LinkedAt: e.LinkedAt}, true
+		case SourceLeetcode:
+			return EventTypeLeetCodeAccountBound, LeetCodeAccountBound{UserID: e.UserID, LeetCodeUsername: e.Username, BoundAt: e.LinkedAt, Verified: e.Verified}, true
+		case SourceMonkeytype:
+			return EventTypeMonkeytypeAccountBound, MonkeytypeAccountBound{UserID: e.UserID, MonkeytypeUsername: e.Username, BoundAt: e.LinkedAt, Verified: e.Verified}, true
+		case SourceCodeforces:
+			return EventTypeCodeforcesAccountBound, CodeforcesAccountBound{UserID: e.UserID, CodeforcesHandle: e.Username, BoundAt: e.LinkedAt, Verified: e.Verified}, true
+		case SourceCodewars:
+			return EventTypeCodewarsAccountBound, CodewarsAccountBound{UserID: e.UserID, CodewarsUsername: e.Username, BoundAt: e.LinkedAt, Verified: e.Verified}, true
+		}
+
+	case IntegrationAccountUnlinked:
+		switch e.Source {
+		case SourceGithub:
+			return EventTypeGitHubAccountUnlinked, GitHubAccountUnlinked{UserID: e.UserID, GitHubUsername: e.Username, UnlinkedAt: e.UnlinkedAt}, true
+		case SourceLeetcode:
+			return EventTypeLeetCodeAccountUnbound, LeetCodeAccountUnbound{UserID: e.UserID, LeetCodeUsername: e.Username, UnboundAt: e.UnlinkedAt, Reason: e.Reason}, true
+		case SourceMonkeytype:
+			return EventTypeMonkeytypeAccountUnbound, MonkeytypeAccountUnbound{UserID: e.UserID, MonkeytypeUsername: e.Username, UnboundAt: e.UnlinkedAt, Reason: e.Reason}, true
+		case SourceCodeforces:
+			return EventTypeCodeforcesAccountUnbound, CodeforcesAccountUnbound{UserID: e.UserID, CodeforcesHandle: e.Username, UnboundAt: e.UnlinkedAt, Reason: e.Reason}, true
+		case SourceCodewars:
+			return EventTypeCodewarsAccountUnbound, CodewarsAccountUnbound{UserID: e.UserID, CodewarsUsername: e.Username, UnboundAt: e.UnlinkedAt, Reason: e.Reason}, true
+		}
+
+	case IntegrationVerificationSucceeded:
+		switch e.Source {
+		case SourceLeetcode:
+			return EventTypeLeetCodeVerificationSucceeded, LeetCodeVerificationSucceeded{UserID: e.UserID, LeetCodeUsername: e.Username, VerifiedAt: e.VerifiedAt}, true
+		case SourceMonkeytype:
+			return EventTypeMonkeytypeVerificationSucceeded, MonkeytypeVerificationSucceeded{UserID: e.UserID, MonkeytypeUsername: e.Username, VerifiedAt: e.VerifiedAt}, true
+		case SourceCodeforces:
+			return EventTypeCodeforcesVerificationSucceeded, CodeforcesVerificationSucceeded{UserID: e.UserID, CodeforcesHandle: e.Username, VerifiedAt: e.VerifiedAt}, true
+		case SourceCodewars:
+			return EventTypeCodewarsVerificationSucceeded, CodewarsVerificationSucceeded{UserID: e.UserID, CodewarsUsername: e.Username, VerifiedAt: e.VerifiedAt}, true
+		}
+
+	case IntegrationVerificationFailed:
+		switch e.Source {
+		case SourceLeetcode:
+			return EventTypeLeetCodeVerificationFailed, LeetCodeVerificationFailed{UserID: e.UserID, LeetCodeUsername: e.Username, FailedAt: e.FailedAt, Reason: e.Reason, ErrorCode: e.ErrorCode}, true
+		case SourceMonkeytype:
+			return EventTypeMonkeytypeVerificationFailed, MonkeytypeVerificationFailed{UserID: e.UserID, MonkeytypeUsername: e.Username, FailedAt: e.FailedAt, Reason: e.Reason, ErrorCode: e.ErrorCode}, true
+		case SourceCodeforces:
+			return EventTypeCodeforcesVerificationFailed, CodeforcesVerificationFailed{UserID: e.UserID, CodeforcesHandle: e.Username, FailedAt: e.FailedAt, Reason: e.Reason, ErrorCode: e.ErrorCode}, true
+		case SourceCodewars:
+			return EventTypeCodewarsVerificationFailed, CodewarsVerificationFailed{UserID: e.UserID, CodewarsUsername: e.Username, FailedAt: e.FailedAt, Reason: e.Reason, ErrorCode: e.ErrorCode}, true
+		}
+	}
+	return "", nil, false
+}
+
+// deref returns the struct a pointer payload points to, as returned by Decode
+func deref(payload interface{}) interface{} {
+	v := reflect.ValueOf(payload)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		return v.Elem().Interface()
+	}
+	return payload
+}
+
+func linked(userID uuid.UUID, source Source, username string, verified bool, at time.Time) (string, interface{}, bool) {
+	return EventTypeIntegrationAccountLinked, IntegrationAccountLinked{UserID: userID, Source: source, Username: username, Verified: verified, LinkedAt: at}, true
+}
+
+func unlinked(userID uuid.UUID, source Source, username, reason string, at time.Time) (string, interface{}, bool) {
+	return EventTypeIntegrationAccountUnlinked, IntegrationAccountUnlinked{UserID: userID, Source: source, Username: username, Reason: reason, UnlinkedAt: at}, true
+}
+
+func verified(userID uuid.UUID, source Source, username string, at time.Time) (string, interface{}, bool) {
+	return EventTypeIntegrationVerificationSucceeded, IntegrationVerificationSucceeded{UserID: userID, Source: source, Username: username, VerifiedAt: at}, true
+}
+
+func verificationFailed(userID uuid.UUID, source Source, username, reason, code string, at time.Time) (string, interface{}, bool) {
+	return EventTypeIntegrationVerificationFailed, IntegrationVerificationFailed{UserID: userID, Source: source, Username: username, Reason: reason, ErrorCode: code, FailedAt: at}, true
+}
+
+// ────────────────────────────────────────────────
+// Validation
+// ────────────────────────────────────────────────
+
+func (e IntegrationAccountLinked) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.source("source", e.Source)
+	c.requireString("username", e.Username)
+	c.requireTime("linked_at", e.LinkedAt)
+	return c.err()
+}
+
+func (e IntegrationAccountUnlinked) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.source("source", e.Source)
+	c.requireTime("unlinked_at", e.UnlinkedAt)
+	return c.err()
+}
+
+func (e IntegrationVerificationSucceeded) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.source("source", e.Source)
+	c.requireString("username", e.Username)
+	c.requireTime("verified_at", e.VerifiedAt)
+	return c.err()
+}
+
+func (e IntegrationVerificationFailed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.source("source", e.Source)
+	c.requireString("reason", e.Reason)
+	c.requireTime("failed_at", e.FailedAt)
+	return c.err()
+}
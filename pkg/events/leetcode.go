@@ -24,27 +24,27 @@ const (
 
 type LeetCodeAccountBound struct {
 	UserID           uuid.UUID `json:"user_id"`
-	LeetCodeUsername string    `json:"leetcode_username"`
+	LeetCodeUsername string    `json:"leetcode_username" pii:"true"`
 	BoundAt          time.Time `json:"bound_at"`
 	Verified         bool      `json:"verified"`
 }
 
 type LeetCodeAccountUnbound struct {
 	UserID           uuid.UUID `json:"user_id"`
-	LeetCodeUsername string    `json:"leetcode_username,omitempty"`
+	LeetCodeUsername string    `json:"leetcode_username,omitempty" pii:"true"`
 	UnboundAt        time.Time `json:"unbound_at"`
 	Reason           string    `json:"reason,omitempty"` // "manual", "verification_timeout", ...
 }
 
 type LeetCodeVerificationSucceeded struct {
 	UserID           uuid.UUID `json:"user_id"`
-	LeetCodeUsername string    `json:"leetcode_username"`
+	LeetCodeUsername string    `json:"leetcode_username" pii:"true"`
 	VerifiedAt       time.Time `json:"verified_at"`
 }
 
 type LeetCodeVerificationFailed struct {
 	UserID           uuid.UUID `json:"user_id"`
-	LeetCodeUsername string    `json:"leetcode_username,omitempty"`
+	LeetCodeUsername string    `json:"leetcode_username,omitempty" pii:"true"`
 	FailedAt         time.Time `json:"failed_at"`
 	Reason           string    `json:"reason"` // "timeout", "token_not_found", "bio_fetch_failed", "persistence_error", ...
 	ErrorCode        string    `json:"error_code,omitempty"`
@@ -52,7 +52,7 @@ type LeetCodeVerificationFailed struct {
 
 type LeetCodeProfileUpdated struct {
 	UserID           uuid.UUID `json:"user_id"`
-	LeetCodeUsername string    `json:"leetcode_username"`
+	LeetCodeUsername string    `json:"leetcode_username" pii:"true"`
 	TotalSolved      int       `json:"total_solved"`
 	EasySolved       int       `json:"easy_solved"`
 	MediumSolved     int       `json:"medium_solved"`
@@ -64,7 +64,7 @@ type LeetCodeProfileUpdated struct {
 
 type LeetCodeHistoryImportCompleted struct {
 	UserID           uuid.UUID `json:"user_id"`
-	LeetCodeUsername string    `json:"leetcode_username"`
+	LeetCodeUsername string    `json:"leetcode_username" pii:"true"`
 	YearsImported    []int     `json:"years_imported"`
 	TotalYears       int       `json:"total_years"`
 	CompletedAt      time.Time `json:"completed_at"`
@@ -72,7 +72,7 @@ type LeetCodeHistoryImportCompleted struct {
 
 type LeetCodeHistoryImportFailed struct {
 	UserID           uuid.UUID `json:"user_id"`
-	LeetCodeUsername string    `json:"leetcode_username"`
+	LeetCodeUsername string    `json:"leetcode_username" pii:"true"`
 	YearsAttempted   []int     `json:"years_attempted"`
 	Error            string    `json:"error"`
 	ErrorCode        string    `json:"error_code,omitempty"` // "rate_limit", "not_found", "timeout"
@@ -81,9 +81,85 @@ type LeetCodeHistoryImportFailed struct {
 
 type LeetCodeCurrentYearRefreshed struct {
 	UserID           uuid.UUID `json:"user_id"`
-	LeetCodeUsername string    `json:"leetcode_username"`
+	LeetCodeUsername string    `json:"leetcode_username" pii:"true"`
 	Year             int       `json:"year"`
 	QuestionsSolved  int       `json:"questions_solved"`
 	ActiveDays       int       `json:"active_days,omitempty"`
 	RefreshedAt      time.Time `json:"refreshed_at"`
 }
+
+// ────────────────────────────────────────────────
+// Validation
+// ────────────────────────────────────────────────
+
+func (e LeetCodeAccountBound) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("leetcode_username", e.LeetCodeUsername)
+	c.requireTime("bound_at", e.BoundAt)
+	return c.err()
+}
+
+func (e LeetCodeAccountUnbound) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireTime("unbound_at", e.UnboundAt)
+	return c.err()
+}
+
+func (e LeetCodeVerificationSucceeded) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("leetcode_username", e.LeetCodeUsername)
+	c.requireTime("verified_at", e.VerifiedAt)
+	return c.err()
+}
+
+func (e LeetCodeVerificationFailed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireTime("failed_at", e.FailedAt)
+	c.requireString("reason", e.Reason)
+	return c.err()
+}
+
+func (e LeetCodeProfileUpdated) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("leetcode_username", e.LeetCodeUsername)
+	c.nonNegative("total_solved", e.TotalSolved)
+	c.nonNegative("easy_solved", e.EasySolved)
+	c.nonNegative("medium_solved", e.MediumSolved)
+	c.nonNegative("hard_solved", e.HardSolved)
+	c.requireTime("updated_at", e.UpdatedAt)
+	return c.err()
+}
+
+func (e LeetCodeHistoryImportCompleted) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("leetcode_username", e.LeetCodeUsername)
+	c.nonNegative("total_years", e.TotalYears)
+	c.requireTime("completed_at", e.CompletedAt)
+	return c.err()
+}
+
+func (e LeetCodeHistoryImportFailed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("leetcode_username", e.LeetCodeUsername)
+	c.requireString("error", e.Error)
+	c.requireTime("failed_at", e.FailedAt)
+	return c.err()
+}
+
+func (e LeetCodeCurrentYearRefreshed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("leetcode_username", e.LeetCodeUsername)
+	c.positive("year", e.Year)
+	c.nonNegative("questions_solved", e.QuestionsSolved)
+	c.nonNegative("active_days", e.ActiveDays)
+	c.requireTime("refreshed_at", e.RefreshedAt)
+	return c.err()
+}
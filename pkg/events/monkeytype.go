@@ -23,6 +23,10 @@ const (
 
 	EventTypeMonkeytypeCurrentStatsRefreshed = "monkeytype.current-stats.refreshed"
 
+	EventTypeMonkeytypeHistoryImportCompleted = "monkeytype.history.import.completed"
+
+	EventTypeMonkeytypeHistoryImportFailed = "monkeytype.history.import.failed"
+
 	EventTypeMonkeytypeTodayContributed = "monkeytype.today.contributed"
 )
 
@@ -34,7 +38,7 @@ const (
 // Fired right after successful BindMonkeytype call (before verification)
 type MonkeytypeAccountBound struct {
 	UserID             uuid.UUID `json:"user_id"`
-	MonkeytypeUsername string    `json:"monkeytype_username"`
+	MonkeytypeUsername string    `json:"monkeytype_username" pii:"true"`
 	BoundAt            time.Time `json:"bound_at"`
 	Verified           bool      `json:"verified"` // usually false at this stage
 }
@@ -43,7 +47,7 @@ type MonkeytypeAccountBound struct {
 // Fired after UnbindMonkeytype
 type MonkeytypeAccountUnbound struct {
 	UserID             uuid.UUID `json:"user_id"`
-	MonkeytypeUsername string    `json:"monkeytype_username,omitempty"`
+	MonkeytypeUsername string    `json:"monkeytype_username,omitempty" pii:"true"`
 	UnboundAt          time.Time `json:"unbound_at"`
 	Reason             string    `json:"reason,omitempty"` // "manual", "verification_timeout", "user_requested", ...
 }
@@ -52,7 +56,7 @@ type MonkeytypeAccountUnbound struct {
 // Fired when token is found in bio and Keycloak attribute is updated
 type MonkeytypeVerificationSucceeded struct {
 	UserID             uuid.UUID `json:"user_id"`
-	MonkeytypeUsername string    `json:"monkeytype_username"`
+	MonkeytypeUsername string    `json:"monkeytype_username" pii:"true"`
 	VerifiedAt         time.Time `json:"verified_at"`
 }
 
@@ -60,7 +64,7 @@ type MonkeytypeVerificationSucceeded struct {
 // Fired on timeout, manual cancel, bio fetch error, Keycloak failure, etc
 type MonkeytypeVerificationFailed struct {
 	UserID             uuid.UUID `json:"user_id"`
-	MonkeytypeUsername string    `json:"monkeytype_username,omitempty"`
+	MonkeytypeUsername string    `json:"monkeytype_username,omitempty" pii:"true"`
 	FailedAt           time.Time `json:"failed_at"`
 	Reason             string    `json:"reason"` // "timeout", "cancelled", "bio_error", "keycloak_error", ...
 	ErrorCode          string    `json:"error_code,omitempty"`
@@ -70,7 +74,7 @@ type MonkeytypeVerificationFailed struct {
 // Fired after successful profile fetch & persistence (initial bind or force refresh)
 type MonkeytypeProfileUpdated struct {
 	UserID             uuid.UUID `json:"user_id"`
-	MonkeytypeUsername string    `json:"monkeytype_username"`
+	MonkeytypeUsername string    `json:"monkeytype_username" pii:"true"`
 	WpmBest            float64   `json:"wpm_best,omitempty"`
 	AccuracyBest       float64   `json:"accuracy_best,omitempty"`
 	TestsCompleted     int       `json:"tests_completed,omitempty"`
@@ -82,8 +86,162 @@ type MonkeytypeProfileUpdated struct {
 // MonkeytypeCurrentStatsRefreshed
 // Fired when current typing stats are refreshed (can be called periodically / on demand)
 type MonkeytypeCurrentStatsRefreshed struct {
+	UserID             uuid.UUID          `json:"user_id"`
+	MonkeytypeUsername string             `json:"monkeytype_username" pii:"true"`
+	TestsToday         int                `json:"tests_today,omitempty"`
+	BestWpm            *MonkeytypeBestWpm `json:"best_wpm,omitempty"`
+	RefreshedAt        time.Time          `json:"refreshed_at"`
+}
+
+// MonkeytypeBestWpm
+// Personal bests per mode, 0 when the mode was never played
+type MonkeytypeBestWpm struct {
+	Time15s float64 `json:"time_15s,omitempty"`
+	Time60s float64 `json:"time_60s,omitempty"`
+	Words   float64 `json:"words,omitempty"` // best of all word counts
+}
+
+// MonkeytypeDailyTests
+// Number of tests completed on one day
+type MonkeytypeDailyTests struct {
+	Date  string `json:"date"` // "2006-01-02", UTC as reported by Monkeytype
+	Tests int    `json:"tests"`
+}
+
+// MonkeytypeHistoryImportCompleted
+// Fired after the test activity of past years was imported
+type MonkeytypeHistoryImportCompleted struct {
+	UserID             uuid.UUID              `json:"user_id"`
+	MonkeytypeUsername string                 `json:"monkeytype_username" pii:"true"`
+	YearsImported      []int                  `json:"years_imported"`
+	TotalYears         int                    `json:"total_years"`
+	DailyTests         []MonkeytypeDailyTests `json:"daily_tests"` // days without tests are left out
+	CompletedAt        time.Time              `json:"completed_at"`
+}
+
+// MonkeytypeHistoryImportFailed
+// Fired when the import gave up. DailyTests holds the days imported before the failure
+type MonkeytypeHistoryImportFailed struct {
+	UserID             uuid.UUID              `json:"user_id"`
+	MonkeytypeUsername string                 `json:"monkeytype_username" pii:"true"`
+	YearsAttempted     []int                  `json:"years_attempted"`
+	DailyTests         []MonkeytypeDailyTests `json:"daily_tests,omitempty"`
+	Error              string                 `json:"error"`
+	ErrorCode          string                 `json:"error_code,omitempty"` // "rate_limit", "not_found", "timeout", "ape_key_invalid"
+	FailedAt           time.Time              `json:"failed_at"`
+}
+
+// MonkeytypeTodayContributed
+// Fired when the tests of the current day change. Count is the running total of the day
+type MonkeytypeTodayContributed struct {
 	UserID             uuid.UUID `json:"user_id"`
-	MonkeytypeUsername string    `json:"monkeytype_username"`
-	TestsToday         int       `json:"tests_today,omitempty"`
-	RefreshedAt        time.Time `json:"refreshed_at"`
+	MonkeytypeUsername string    `json:"monkeytype_username" pii:"true"`
+	Count              int       `json:"count"`
+	TimeTyping         int       `json:"time_typing,omitempty"` // seconds
+	Date               string    `json:"date"`                  // local calendar date, "2006-01-02"
+	Timezone           string    `json:"timezone,omitempty"`    // IANA timezone of the user
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// TodayContributed returns the source-independent event of the same day
+func (e MonkeytypeTodayContributed) TodayContributed() TodayContributedEvent {
+	return TodayContributedEvent{
+		UserID:   e.UserID,
+		Source:   SourceMonkeytype,
+		Count:    e.Count,
+		Date:     e.Date,
+		Timezone: e.Timezone,
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Validation
+// -----------------------------------------------------------------------------
+
+func (e MonkeytypeAccountBound) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("monkeytype_username", e.MonkeytypeUsername)
+	c.requireTime("bound_at", e.BoundAt)
+	return c.err()
+}
+
+func (e MonkeytypeAccountUnbound) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireTime("unbound_at", e.UnboundAt)
+	return c.err()
+}
+
+func (e MonkeytypeVerificationSucceeded) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("monkeytype_username", e.MonkeytypeUsername)
+	c.requireTime("verified_at", e.VerifiedAt)
+	return c.err()
+}
+
+func (e MonkeytypeVerificationFailed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireTime("failed_at", e.FailedAt)
+	c.requireString("reason", e.Reason)
+	return c.err()
+}
+
+func (e MonkeytypeProfileUpdated) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("monkeytype_username", e.MonkeytypeUsername)
+	c.nonNegativeFloat("wpm_best", e.WpmBest)
+	c.nonNegativeFloat("accuracy_best", e.AccuracyBest)
+	c.nonNegative("tests_completed", e.TestsCompleted)
+	c.requireTime("updated_at", e.UpdatedAt)
+	return c.err()
+}
+
+func (e MonkeytypeCurrentStatsRefreshed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("monkeytype_username", e.MonkeytypeUsername)
+	c.nonNegative("tests_today", e.TestsToday)
+	if e.BestWpm != nil {
+		c.nonNegativeFloat("best_wpm.time_15s", e.BestWpm.Time15s)
+		c.nonNegativeFloat("best_wpm.time_60s", e.BestWpm.Time60s)
+		c.nonNegativeFloat("best_wpm.words", e.BestWpm.Words)
+	}
+	c.requireTime("refreshed_at", e.RefreshedAt)
+	return c.err()
+}
+
+func (e MonkeytypeHistoryImportCompleted) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("monkeytype_username", e.MonkeytypeUsername)
+	c.nonNegative("total_years", e.TotalYears)
+	c.dailyTests("daily_tests", e.DailyTests)
+	c.requireTime("completed_at", e.CompletedAt)
+	return c.err()
+}
+
+func (e MonkeytypeHistoryImportFailed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("monkeytype_username", e.MonkeytypeUsername)
+	c.dailyTests("daily_tests", e.DailyTests)
+	c.requireString("error", e.Error)
+	c.requireTime("failed_at", e.FailedAt)
+	return c.err()
+}
+
+func (e MonkeytypeTodayContributed) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("monkeytype_username", e.MonkeytypeUsername)
+	c.nonNegative("count", e.Count)
+	c.nonNegative("time_typing", e.TimeTyping)
+	c.requireDay("date", e.Date)
+	c.timezone("timezone", e.Timezone)
+	c.requireTime("updated_at", e.UpdatedAt)
+	return c.err()
 }
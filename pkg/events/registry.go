@@ -0,0 +1,182 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+//go:generate go run ../cmd/eventschema -out ../../docs/events
+
+type Domain string
+
+const (
+	DomainUser         Domain = "user"
+	DomainIntegration  Domain = "integration"
+	DomainEngagement   Domain = "engagement"
+	DomainGamification Domain = "gamification"
+)
+
+const (
+	TopicUserEvents         = "user-events"
+	TopicIntegrationEvents  = "integration-events"
+	TopicEngagementEvents   = "engagement-events"
+	TopicGamificationEvents = "gamification-events"
+)
+
+// Descriptor ties an event type constant to its payload, owning domain and topic
+type Descriptor struct {
+	Type    string
+	Domain  Domain
+	Topic   string
+	Payload reflect.Type
+}
+
+// New returns a pointer to a zero payload of the event type
+func (d Descriptor) New() interface{} {
+	return reflect.New(d.Payload).Interface()
+}
+
+var registry = map[string]Descriptor{}
+
+func register(eventType string, domain Domain, topic string, payload interface{}) {
+	if _, ok := registry[eventType]; ok {
+		panic(fmt.Sprintf("events: %s registered twice", eventType))
+	}
+	registry[eventType] = Descriptor{
+		Type:    eventType,
+		Domain:  domain,
+		Topic:   topic,
+		Payload: reflect.TypeOf(payload),
+	}
+}
+
+// Lookup returns the descriptor of an event type
+func Lookup(eventType string) (Descriptor, bool) {
+	d, ok := registry[eventType]
+	return d, ok
+}
+
+// Registered returns every registered event sorted by type
+func Registered() []Descriptor {
+	list := make([]Descriptor, 0, len(registry))
+	for _, d := range registry {
+		list = append(list, d)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Type < list[j].Type })
+	return list
+}
+
+// Decode unmarshals and validates the payload of a registered event type.
+// The result is a pointer to the payload struct
+func Decode(eventType string, data json.RawMessage) (interface{}, error) {
+	d, ok := registry[eventType]
+	if !ok {
+		return nil, fmt.Errorf("unknown event type %s", eventType)
+	}
+
+	payload := d.New()
+	if err := json.Unmarshal(data, payload); err != nil {
+		return nil, fmt.Errorf("decode %s payload failed: %w", eventType, err)
+	}
+	if err := Validate(payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func init() {
+	// User
+	register(EventTypeUserRegistered, DomainUser, TopicUserEvents, UserRegistered{})
+	register(EventTypeUserUpdated, DomainUser, TopicUserEvents, UserUpdated{})
+	register(EventTypeUserDeleted, DomainUser, TopicUserEvents, UserDeleted{})
+	register(EventTypeUserVerified, DomainUser, TopicUserEvents, UserEmailVerified{})
+	register(EventTypeAvatarUpdatedEvent, DomainUser, TopicUserEvents, AvatarUpdatedEvent{})
+	register(EventTypeAvatarProcessingFinishedEvent, DomainUser, TopicUserEvents, AvatarProcessingFinishedEvent{})
+	register(EventTypeUserFollowed, DomainUser, TopicUserEvents, UserFollowed{})
+	register(EventTypeUserUnfollowed, DomainUser, TopicUserEvents, UserUnfollowed{})
+	register(EventTypeLoginSucceeded, DomainUser, TopicUserEvents, LoginSucceeded{})
+	register(EventTypeLoginFailed, DomainUser, TopicUserEvents, LoginFailed{})
+	register(EventTypeNewDeviceLogin, DomainUser, TopicUserEvents, NewDeviceLogin{})
+	register(EventTypePasswordChanged, DomainUser, TopicUserEvents, PasswordChanged{})
+	register(EventTypeEmailChanged, DomainUser, TopicUserEvents, EmailChanged{})
+	register(EventTypeSessionRevoked, DomainUser, TopicUserEvents, SessionRevoked{})
+	register(EventTypeMFAEnabled, DomainUser, TopicUserEvents, MFAEnabled{})
+
+	// Integrations
+	register(EventTypeTodayContributed, DomainIntegration, TopicIntegrationEvents, TodayContributedEvent{})
+
+	register(EventTypeIntegrationAccountLinked, DomainIntegration, TopicIntegrationEvents, IntegrationAccountLinked{})
+	register(EventTypeIntegrationAccountUnlinked, DomainIntegration, TopicIntegrationEvents, IntegrationAccountUnlinked{})
+	register(EventTypeIntegrationVerificationSucceeded, DomainIntegration, TopicIntegrationEvents, IntegrationVerificationSucceeded{})
+	register(EventTypeIntegrationVerificationFailed, DomainIntegration, TopicIntegrationEvents, IntegrationVerificationFailed{})
+
+	register(EventTypeGitHubAccountLinked, DomainIntegration, TopicIntegrationEvents, GitHubAccountLinked{})
+	register(EventTypeGitHubAccountUnlinked, DomainIntegration, TopicIntegrationEvents, GitHubAccountUnlinked{})
+	register(EventTypeGitHubProfileUpdated, DomainIntegration, TopicIntegrationEvents, GitHubProfileUpdated{})
+	register(EventTypeGitHubHistoryImportCompleted, DomainIntegration, TopicIntegrationEvents, GitHubHistoryImportCompleted{})
+	register(EventTypeGitHubHistoryImportFailed, DomainIntegration, TopicIntegrationEvents, GitHubHistoryImportFailed{})
+	register(EventTypeGitHubCurrentYearRefreshed, DomainIntegration, TopicIntegrationEvents, GitHubCurrentYearRefreshed{})
+
+	register(EventTypeLeetCodeAccountBound, DomainIntegration, TopicIntegrationEvents, LeetCodeAccountBound{})
+	register(EventTypeLeetCodeAccountUnbound, DomainIntegration, TopicIntegrationEvents, LeetCodeAccountUnbound{})
+	register(EventTypeLeetCodeVerificationSucceeded, DomainIntegration, TopicIntegrationEvents, LeetCodeVerificationSucceeded{})
+	register(EventTypeLeetCodeVerificationFailed, DomainIntegration, TopicIntegrationEvents, LeetCodeVerificationFailed{})
+	register(EventTypeLeetCodeProfileUpdated, DomainIntegration, TopicIntegrationEvents, LeetCodeProfileUpdated{})
+	register(EventTypeLeetCodeHistoryImportCompleted, DomainIntegration, TopicIntegrationEvents, LeetCodeHistoryImportCompleted{})
+	register(EventTypeLeetCodeHistoryImportFailed, DomainIntegration, TopicIntegrationEvents, LeetCodeHistoryImportFailed{})
+	register(EventTypeLeetCodeCurrentYearRefreshed, DomainIntegration, TopicIntegrationEvents, LeetCodeCurrentYearRefreshed{})
+
+	register(EventTypeMonkeytypeAccountBound, DomainIntegration, TopicIntegrationEvents, MonkeytypeAccountBound{})
+	register(EventTypeMonkeytypeAccountUnbound, DomainIntegration, TopicIntegrationEvents, MonkeytypeAccountUnbound{})
+	register(EventTypeMonkeytypeVerificationSucceeded, DomainIntegration, TopicIntegrationEvents, MonkeytypeVerificationSucceeded{})
+	register(EventTypeMonkeytypeVerificationFailed, DomainIntegration, TopicIntegrationEvents, MonkeytypeVerificationFailed{})
+	register(EventTypeMonkeytypeProfileUpdated, DomainIntegration, TopicIntegrationEvents, MonkeytypeProfileUpdated{})
+	register(EventTypeMonkeytypeCurrentStatsRefreshed, DomainIntegration, TopicIntegrationEvents, MonkeytypeCurrentStatsRefreshed{})
+	register(EventTypeMonkeytypeHistoryImportCompleted, DomainIntegration, TopicIntegrationEvents, MonkeytypeHistoryImportCompleted{})
+	register(EventTypeMonkeytypeHistoryImportFailed, DomainIntegration, TopicIntegrationEvents, MonkeytypeHistoryImportFailed{})
+	register(EventTypeMonkeytypeTodayContributed, DomainIntegration, TopicIntegrationEvents, MonkeytypeTodayContributed{})
+
+	register(EventTypeCodeforcesAccountBound, DomainIntegration, TopicIntegrationEvents, CodeforcesAccountBound{})
+	register(EventTypeCodeforcesAccountUnbound, DomainIntegration, TopicIntegrationEvents, CodeforcesAccountUnbound{})
+	register(EventTypeCodeforcesVerificationSucceeded, DomainIntegration, TopicIntegrationEvents, CodeforcesVerificationSucceeded{})
+	register(EventTypeCodeforcesVerificationFailed, DomainIntegration, TopicIntegrationEvents, CodeforcesVerificationFailed{})
+	register(EventTypeCodeforcesProfileUpdated, DomainIntegration, TopicIntegrationEvents, CodeforcesProfileUpdated{})
+	register(EventTypeCodeforcesHistoryImportCompleted, DomainIntegration, TopicIntegrationEvents, CodeforcesHistoryImportCompleted{})
+	register(EventTypeCodeforcesHistoryImportFailed, DomainIntegration, TopicIntegrationEvents, CodeforcesHistoryImportFailed{})
+	register(EventTypeCodeforcesCurrentYearRefreshed, DomainIntegration, TopicIntegrationEvents, CodeforcesCurrentYearRefreshed{})
+	register(EventTypeCodeforcesTodayContributed, DomainIntegration, TopicIntegrationEvents, CodeforcesTodayContributed{})
+
+	register(EventTypeCodewarsAccountBound, DomainIntegration, TopicIntegrationEvents, CodewarsAccountBound{})
+	register(EventTypeCodewarsAccountUnbound, DomainIntegration, TopicIntegrationEvents, CodewarsAccountUnbound{})
+	register(EventTypeCodewarsVerificationSucceeded, DomainIntegration, TopicIntegrationEvents, CodewarsVerificationSucceeded{})
+	register(EventTypeCodewarsVerificationFailed, DomainIntegration, TopicIntegrationEvents, CodewarsVerificationFailed{})
+	register(EventTypeCodewarsProfileUpdated, DomainIntegration, TopicIntegrationEvents, CodewarsProfileUpdated{})
+	register(EventTypeCodewarsHistoryImportCompleted, DomainIntegration, TopicIntegrationEvents, CodewarsHistoryImportCompleted{})
+	register(EventTypeCodewarsHistoryImportFailed, DomainIntegration, TopicIntegrationEvents, CodewarsHistoryImportFailed{})
+	register(EventTypeCodewarsCurrentYearRefreshed, DomainIntegration, TopicIntegrationEvents, CodewarsCurrentYearRefreshed{})
+	register(EventTypeCodewarsTodayContributed, DomainIntegration, TopicIntegrationEvents, CodewarsTodayContributed{})
+
+	// Engagement
+	register(EventTypeDiscussionCreated, DomainEngagement, TopicEngagementEvents, DiscussionCreated{})
+	register(EventTypeDiscussionUpdated, DomainEngagement, TopicEngagementEvents, DiscussionUpdated{})
+	register(EventTypeDiscussionDeleted, DomainEngagement, TopicEngagementEvents, DiscussionDeleted{})
+	register(EventTypeCommentCreated, DomainEngagement, TopicEngagementEvents, CommentCreated{})
+	register(EventTypeCommentDeleted, DomainEngagement, TopicEngagementEvents, CommentDeleted{})
+	register(EventTypeCommentReplied, DomainEngagement, TopicEngagementEvents, CommentReplied{})
+	register(EventTypeReactionAdded, DomainEngagement, TopicEngagementEvents, ReactionAdded{})
+	register(EventTypeReactionRemoved, DomainEngagement, TopicEngagementEvents, ReactionRemoved{})
+	register(EventTypeDiscussionUserMentioned, DomainEngagement, TopicEngagementEvents, DiscussionUserMentioned{})
+	register(EventTypeStreakExtended, DomainEngagement, TopicEngagementEvents, StreakExtended{})
+	register(EventTypeStreakBroken, DomainEngagement, TopicEngagementEvents, StreakBroken{})
+	register(EventTypeStreakFrozen, DomainEngagement, TopicEngagementEvents, StreakFrozen{})
+	register(EventTypeStreakMilestone, DomainEngagement, TopicEngagementEvents, StreakMilestone{})
+
+	// Gamification
+	register(EventTypeAchievementGranted, DomainGamification, TopicGamificationEvents, AchievementGrantedEvent{})
+	register(EventTypeAchievementProgressed, DomainGamification, TopicGamificationEvents, AchievementProgressed{})
+	register(EventTypeAchievementRevoked, DomainGamification, TopicGamificationEvents, AchievementRevoked{})
+	register(EventTypeXPAwarded, DomainGamification, TopicGamificationEvents, XPAwarded{})
+	register(EventTypeLevelUp, DomainGamification, TopicGamificationEvents, LevelUp{})
+}
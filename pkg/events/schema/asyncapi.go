@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+)
+
+const asyncAPIVersion = "2.6.0"
+
+type Info struct {
+	Title       string
+	Version     string
+	Description string
+}
+
+// AsyncAPI builds an AsyncAPI document with one channel per topic. Every message is the
+// {type,data} envelope written by messaging.KafkaProducer
+func AsyncAPI(info Info, descriptors []events.Descriptor) map[string]interface{} {
+	channels := map[string]interface{}{}
+	messages := map[string]interface{}{}
+	schemas := map[string]interface{}{}
+	byTopic := map[string][]interface{}{}
+	var topics []string
+
+	for _, d := range descriptors {
+		name := d.Payload.Name()
+		schemas[name] = ForType(d.Payload)
+
+		messages[d.Type] = map[string]interface{}{
+			"name":        d.Type,
+			"title":       name,
+			"contentType": "application/json",
+			"tags":        []interface{}{map[string]interface{}{"name": string(d.Domain)}},
+			"payload": Schema{
+				"type":     "object",
+				"required": []string{"type", "data"},
+				"properties": Schema{
+					"type": Schema{"type": "string", "const": d.Type},
+					"data": Schema{"$ref": "#/components/schemas/" + name},
+				},
+			},
+		}
+
+		if _, ok := byTopic[d.Topic]; !ok {
+			topics = append(topics, d.Topic)
+		}
+		byTopic[d.Topic] = append(byTopic[d.Topic], map[string]interface{}{"$ref": "#/components/messages/" + d.Type})
+	}
+
+	for _, topic := range topics {
+		channels[topic] = map[string]interface{}{
+			"subscribe": map[string]interface{}{
+				"operationId": "consume-" + topic,
+				"message":     map[string]interface{}{"oneOf": byTopic[topic]},
+			},
+			"bindings": map[string]interface{}{
+				"kafka": map[string]interface{}{"topic": topic},
+			},
+		}
+	}
+
+	return map[string]interface{}{
+		"asyncapi": asyncAPIVersion,
+		"info": map[string]interface{}{
+			"title":       info.Title,
+			"version":     info.Version,
+			"description": info.Description,
+		},
+		"defaultContentType": "application/json",
+		"channels":           channels,
+		"components": map[string]interface{}{
+			"messages": messages,
+			"schemas":  schemas,
+		},
+	}
+}
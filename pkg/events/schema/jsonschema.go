@@ -0,0 +1,165 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+)
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema document
+type Schema map[string]interface{}
+
+var (
+	uuidType    = reflect.TypeOf(uuid.UUID{})
+	timeType    = reflect.TypeOf(time.Time{})
+	rawType     = reflect.TypeOf(json.RawMessage{})
+	sourceType  = reflect.TypeOf(events.Source(""))
+	tierType    = reflect.TypeOf(events.AchievementTier(""))
+	enumsByType = map[reflect.Type]func() []string{
+		sourceType: func() []string {
+			var values []string
+			for _, s := range events.Sources() {
+				values = append(values, string(s))
+			}
+			return values
+		},
+		tierType: func() []string {
+			var values []string
+			for _, t := range events.AchievementTiers() {
+				values = append(values, string(t))
+			}
+			return values
+		},
+	}
+)
+
+// ForEvent returns the standalone JSON Schema of an event payload
+func ForEvent(d events.Descriptor) Schema {
+	s := ForType(d.Payload)
+	s["$schema"] = jsonSchemaDraft
+	s["$id"] = d.Type + ".schema.json"
+	s["title"] = d.Payload.Name()
+	s["x-event-type"] = d.Type
+	s["x-domain"] = string(d.Domain)
+	s["x-topic"] = d.Topic
+	return s
+}
+
+// ForType maps a Go type onto JSON Schema following encoding/json rules
+func ForType(t reflect.Type) Schema {
+	if enum, ok := enumsByType[t]; ok {
+		return Schema{"type": "string", "enum": enum()}
+	}
+
+	switch t {
+	case uuidType:
+		return Schema{"type": "string", "format": "uuid"}
+	case timeType:
+		return Schema{"type": "string", "format": "date-time"}
+	case rawType:
+		return Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		s := ForType(t.Elem())
+		if typ, ok := s["type"].(string); ok {
+			s["type"] = []string{typ, "null"}
+		}
+		return s
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return Schema{"type": "string", "contentEncoding": "base64"}
+		}
+		return Schema{"type": []string{"array", "null"}, "items": ForType(t.Elem())}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": ForType(t.Elem())}
+	case reflect.Struct:
+		return structSchema(t)
+	}
+	return Schema{}
+}
+
+func structSchema(t reflect.Type) Schema {
+	properties := Schema{}
+	var required []string
+
+	for _, f := range Fields(t) {
+		prop := ForType(f.Type)
+		if f.Sensitive {
+			prop["x-pii"] = true
+		}
+		properties[f.Name] = prop
+		if !f.OmitEmpty && f.Type.Kind() != reflect.Ptr {
+			required = append(required, f.Name)
+		}
+	}
+
+	s := Schema{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+// Field is a struct field as seen by encoding/json
+type Field struct {
+	Name      string
+	GoName    string
+	Type      reflect.Type
+	OmitEmpty bool
+	// Sensitive marks personal data (tag `pii:"true"`), encrypted per user by pii.Cipher
+	Sensitive bool
+}
+
+// Fields lists the JSON fields of a struct in declaration order, flattening embedded structs
+func Fields(t reflect.Type) []Field {
+	var fields []Field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			fields = append(fields, Fields(sf.Type)...)
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		fields = append(fields, Field{
+			Name:      name,
+			GoName:    sf.Name,
+			Type:      sf.Type,
+			OmitEmpty: strings.Contains(opts, "omitempty"),
+			Sensitive: sf.Tag.Get("pii") == "true",
+		})
+	}
+	return fields
+}
@@ -0,0 +1,88 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventTypeStreakExtended  = "streak.extended"
+	EventTypeStreakBroken    = "streak.broken"
+	EventTypeStreakFrozen    = "streak.frozen"
+	EventTypeStreakMilestone = "streak.milestone"
+)
+
+// Dates are local calendar dates of the user ("2006-01-02"), see TodayContributedEvent
+
+// StreakExtended is emitted on the first qualifying contribution of a day
+type StreakExtended struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Length     int       `json:"length"`
+	Date       string    `json:"date"`
+	Timezone   string    `json:"timezone,omitempty"`
+	ExtendedAt time.Time `json:"extended_at"`
+}
+
+// StreakBroken is emitted once a missed day is final and no freeze could cover it
+type StreakBroken struct {
+	UserID         uuid.UUID `json:"user_id"`
+	Length         int       `json:"length"` // length of the streak that ended
+	LastActiveDate string    `json:"last_active_date"`
+	BrokenAt       time.Time `json:"broken_at"`
+}
+
+// StreakFrozen is emitted when a freeze token covers a missed day
+type StreakFrozen struct {
+	UserID      uuid.UUID `json:"user_id"`
+	Length      int       `json:"length"`
+	FrozenDate  string    `json:"frozen_date"`
+	FreezesLeft int       `json:"freezes_left"`
+	FrozenAt    time.Time `json:"frozen_at"`
+}
+
+// StreakMilestone is emitted when a streak reaches one of the milestone lengths
+type StreakMilestone struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Milestone int       `json:"milestone"`
+	Date      string    `json:"date"`
+	ReachedAt time.Time `json:"reached_at"`
+}
+
+func (e StreakExtended) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.positive("length", e.Length)
+	c.requireDay("date", e.Date)
+	c.timezone("timezone", e.Timezone)
+	c.requireTime("extended_at", e.ExtendedAt)
+	return c.err()
+}
+
+func (e StreakBroken) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.positive("length", e.Length)
+	c.requireDay("last_active_date", e.LastActiveDate)
+	c.requireTime("broken_at", e.BrokenAt)
+	return c.err()
+}
+
+func (e StreakFrozen) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.nonNegative("length", e.Length)
+	c.requireDay("frozen_date", e.FrozenDate)
+	c.nonNegative("freezes_left", e.FreezesLeft)
+	c.requireTime("frozen_at", e.FrozenAt)
+	return c.err()
+}
+
+func (e StreakMilestone) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.positive("milestone", e.Milestone)
+	c.requireDay("date", e.Date)
+	c.requireTime("reached_at", e.ReachedAt)
+	return c.err()
+}
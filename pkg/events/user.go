@@ -11,13 +11,13 @@ const (
 
 type UserRegistered struct {
 	UserID   uuid.UUID `json:"user_id"`
-	Username string    `json:"username"`
-	Email    string    `json:"email"`
+	Username string    `json:"username" pii:"true"`
+	Email    string    `json:"email" pii:"true"`
 }
 
 type UserUpdated struct {
 	UserID    uuid.UUID `json:"user_id"`
-	Username  string    `json:"username"`
+	Username  string    `json:"username" pii:"true"`
 	AvatarURL string    `json:"avatar_url"`
 }
 
@@ -28,3 +28,30 @@ type UserDeleted struct {
 type UserEmailVerified struct {
 	UserID uuid.UUID `json:"user_id"`
 }
+
+func (e UserRegistered) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("username", e.Username)
+	c.email("email", e.Email)
+	return c.err()
+}
+
+func (e UserUpdated) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.requireString("username", e.Username)
+	return c.err()
+}
+
+func (e UserDeleted) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	return c.err()
+}
+
+func (e UserEmailVerified) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	return c.err()
+}
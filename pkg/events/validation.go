@@ -0,0 +1,172 @@
+package events
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/date"
+)
+
+// Validator is implemented by every event payload
+type Validator interface {
+	Validate() error
+}
+
+// FieldError describes a single invalid payload field, named by its JSON tag
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError lists every invalid field of a payload
+type ValidationError struct {
+	Fields []FieldError `json:"fields"`
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return "invalid event payload: " + strings.Join(msgs, "; ")
+}
+
+// Validate checks the payload if it implements Validator, otherwise it accepts it
+func Validate(payload interface{}) error {
+	if v, ok := payload.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// fieldChecker collects field errors while a payload is validated
+type fieldChecker struct {
+	errs []FieldError
+}
+
+func (c *fieldChecker) add(field, message string) {
+	c.errs = append(c.errs, FieldError{Field: field, Message: message})
+}
+
+func (c *fieldChecker) requireUUID(field string, v uuid.UUID) {
+	if v == uuid.Nil {
+		c.add(field, "is required")
+	}
+}
+
+func (c *fieldChecker) requireString(field, v string) {
+	if strings.TrimSpace(v) == "" {
+		c.add(field, "is required")
+	}
+}
+
+func (c *fieldChecker) requireTime(field string, v time.Time) {
+	if v.IsZero() {
+		c.add(field, "is required")
+	}
+}
+
+func (c *fieldChecker) nonNegative(field string, v int) {
+	if v < 0 {
+		c.add(field, fmt.Sprintf("must not be negative, got %d", v))
+	}
+}
+
+func (c *fieldChecker) nonNegativeFloat(field string, v float64) {
+	if v < 0 {
+		c.add(field, fmt.Sprintf("must not be negative, got %g", v))
+	}
+}
+
+func (c *fieldChecker) positive(field string, v int) {
+	if v <= 0 {
+		c.add(field, fmt.Sprintf("must be positive, got %d", v))
+	}
+}
+
+func (c *fieldChecker) source(field string, v Source) {
+	if !v.Valid() {
+		c.add(field, fmt.Sprintf("unknown source %q", v))
+	}
+}
+
+// distinct rejects a relation of a user with themselves
+func (c *fieldChecker) distinct(field string, a, b uuid.UUID) {
+	if a != uuid.Nil && a == b {
+		c.add(field, "must differ from the acting user")
+	}
+}
+
+// tier accepts an empty value or a known tier
+func (c *fieldChecker) tier(field string, v AchievementTier) {
+	if v != "" && !v.Valid() {
+		c.add(field, fmt.Sprintf("unknown tier %q", v))
+	}
+}
+
+func (c *fieldChecker) target(field, v string) {
+	if v != TargetDiscussion && v != TargetComment {
+		c.add(field, fmt.Sprintf("must be %q or %q, got %q", TargetDiscussion, TargetComment, v))
+	}
+}
+
+func (c *fieldChecker) email(field, v string) {
+	c.requireString(field, v)
+	if v != "" && !strings.Contains(v, "@") {
+		c.add(field, "must be an email address")
+	}
+}
+
+// day accepts an empty value or a calendar date in date.DayLayout
+func (c *fieldChecker) day(field, v string) {
+	if v == "" {
+		return
+	}
+	if _, err := time.Parse(date.DayLayout, v); err != nil {
+		c.add(field, fmt.Sprintf("must be a date like 2006-01-02, got %q", v))
+	}
+}
+
+func (c *fieldChecker) requireDay(field, v string) {
+	c.requireString(field, v)
+	c.day(field, v)
+}
+
+// timezone accepts an empty value or an IANA timezone name
+func (c *fieldChecker) timezone(field, v string) {
+	if v == "" {
+		return
+	}
+	if _, err := date.LoadLocation(v); err != nil {
+		c.add(field, fmt.Sprintf("unknown timezone %q", v))
+	}
+}
+
+func (c *fieldChecker) ip(field, v string) {
+	c.requireString(field, v)
+	if v != "" && net.ParseIP(v) == nil {
+		c.add(field, fmt.Sprintf("must be an IP address, got %q", v))
+	}
+}
+
+func (c *fieldChecker) dailyTests(field string, days []MonkeytypeDailyTests) {
+	for i, d := range days {
+		item := fmt.Sprintf("%s[%d]", field, i)
+		c.requireDay(item+".date", d.Date)
+		c.nonNegative(item+".tests", d.Tests)
+	}
+}
+
+func (c *fieldChecker) err() error {
+	if len(c.errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: c.errs}
+}
@@ -0,0 +1,54 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventTypeXPAwarded = "xp.awarded"
+	EventTypeLevelUp   = "level.up"
+)
+
+// XPAwarded is emitted for every XP gain of a user
+type XPAwarded struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Source    Source    `json:"source"`
+	Reason    string    `json:"reason"` // "contribution", "leetcode.hard", "monkeytype.test", ...
+	Amount    int       `json:"amount"`
+	TotalXP   int       `json:"total_xp"` // after the award
+	Level     int       `json:"level"`    // after the award
+	AwardedAt time.Time `json:"awarded_at"`
+}
+
+// LevelUp is emitted when an award moves a user to a higher level, possibly skipping some
+type LevelUp struct {
+	UserID        uuid.UUID `json:"user_id"`
+	Level         int       `json:"level"`
+	PreviousLevel int       `json:"previous_level"`
+	TotalXP       int       `json:"total_xp"`
+	ReachedAt     time.Time `json:"reached_at"`
+}
+
+func (e XPAwarded) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.source("source", e.Source)
+	c.requireString("reason", e.Reason)
+	c.positive("amount", e.Amount)
+	c.nonNegative("total_xp", e.TotalXP)
+	c.positive("level", e.Level)
+	c.requireTime("awarded_at", e.AwardedAt)
+	return c.err()
+}
+
+func (e LevelUp) Validate() error {
+	var c fieldChecker
+	c.requireUUID("user_id", e.UserID)
+	c.positive("level", e.Level)
+	c.positive("previous_level", e.PreviousLevel)
+	c.nonNegative("total_xp", e.TotalXP)
+	c.requireTime("reached_at", e.ReachedAt)
+	return c.err()
+}
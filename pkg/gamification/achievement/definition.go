@@ -0,0 +1,128 @@
+package achievement
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+)
+
+// Threshold is the value a metric must reach to unlock a tier
+type Threshold struct {
+	Tier   events.AchievementTier `json:"tier"`
+	Target int                    `json:"target"`
+}
+
+// Definition describes an achievement for both the gamification service and the
+// frontend, which reads it as JSON. An achievement without tiers has a single threshold
+// with an empty tier
+type Definition struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	IconURL     *string   `json:"icon_url,omitempty"`
+	// Metric names the counted value, e.g. "leetcode.hard_solved" or "streak.length"
+	Metric     string      `json:"metric"`
+	Thresholds []Threshold `json:"thresholds"`
+	// Hidden achievements are only shown once granted
+	Hidden bool `json:"hidden,omitempty"`
+}
+
+// Validate checks that thresholds use known tiers in ascending order with growing targets
+func (d Definition) Validate() error {
+	if d.ID == uuid.Nil || d.Name == "" || d.Metric == "" {
+		return errors.New("achievement definition needs id, name and metric")
+	}
+	if len(d.Thresholds) == 0 {
+		return fmt.Errorf("achievement %s has no thresholds", d.Name)
+	}
+
+	for i, t := range d.Thresholds {
+		if t.Target <= 0 {
+			return fmt.Errorf("achievement %s: target of threshold %d must be positive", d.Name, i)
+		}
+		if len(d.Thresholds) == 1 && t.Tier == "" {
+			continue
+		}
+		if !t.Tier.Valid() {
+			return fmt.Errorf("achievement %s: unknown tier %q", d.Name, t.Tier)
+		}
+		if i > 0 {
+			prev := d.Thresholds[i-1]
+			if tierRank(t.Tier) <= tierRank(prev.Tier) || t.Target <= prev.Target {
+				return fmt.Errorf("achievement %s: thresholds must ascend by tier and target", d.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Reached returns how many thresholds the value unlocks
+func (d Definition) Reached(value int) int {
+	n := 0
+	for _, t := range d.Thresholds {
+		if value >= t.Target {
+			n++
+		}
+	}
+	return n
+}
+
+// Evaluate returns the events for a metric going from previous to current: a grant for
+// every threshold crossed and the progress towards the next one. Lower values produce no
+// events; revocation is an explicit admin decision, see Revoke
+func (d Definition) Evaluate(userID uuid.UUID, previous, current int, at time.Time) []interface{} {
+	if current <= previous {
+		return nil
+	}
+
+	var out []interface{}
+	for _, t := range d.Thresholds {
+		if previous < t.Target && t.Target <= current {
+			out = append(out, events.AchievementGrantedEvent{
+				UserID:        userID,
+				AchievementID: d.ID,
+				Name:          d.Name,
+				Description:   d.Description,
+				IconURL:       d.IconURL,
+				Tier:          t.Tier,
+			})
+		}
+	}
+
+	if next := d.Reached(current); next < len(d.Thresholds) {
+		t := d.Thresholds[next]
+		out = append(out, events.AchievementProgressed{
+			UserID:        userID,
+			AchievementID: d.ID,
+			Tier:          t.Tier,
+			Current:       current,
+			Target:        t.Target,
+			ProgressedAt:  at,
+		})
+	}
+	return out
+}
+
+// Revoke builds the event taking back a tier of the achievement
+func (d Definition) Revoke(userID uuid.UUID, tier events.AchievementTier, reason string, by *uuid.UUID, at time.Time) events.AchievementRevoked {
+	return events.AchievementRevoked{
+		UserID:        userID,
+		AchievementID: d.ID,
+		Tier:          tier,
+		Reason:        reason,
+		RevokedBy:     by,
+		RevokedAt:     at,
+	}
+}
+
+func tierRank(t events.AchievementTier) int {
+	for i, known := range events.AchievementTiers() {
+		if t == known {
+			return i
+		}
+	}
+	return -1
+}
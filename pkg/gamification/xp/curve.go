@@ -0,0 +1,59 @@
+package xp
+
+import "math"
+
+// Curve maps total XP to levels. Level 1 starts at 0 XP and reaching level n takes
+// Base * (n-1)^Exponent XP in total
+type Curve struct {
+	Base     float64 `json:"base"`
+	Exponent float64 `json:"exponent"`
+	// MaxLevel caps the level, 0 means no cap
+	MaxLevel int `json:"max_level,omitempty"`
+}
+
+// DefaultCurve needs 100 XP for level 2, 283 for level 3 and 1118 for level 6
+var DefaultCurve = Curve{Base: 100, Exponent: 1.5}
+
+// Threshold returns the total XP needed to reach the level
+func (c Curve) Threshold(level int) int {
+	if level <= 1 {
+		return 0
+	}
+	return int(math.Round(c.Base * math.Pow(float64(level-1), c.Exponent)))
+}
+
+// Level returns the level reached with the total XP
+func (c Curve) Level(total int) int {
+	if total <= 0 {
+		return 1
+	}
+
+	// Start from the inverse of the curve and correct rounding errors
+	level := 1 + int(math.Pow(float64(total)/c.Base, 1/c.Exponent))
+	for level > 1 && c.Threshold(level) > total {
+		level--
+	}
+	for c.Threshold(level+1) <= total && (c.MaxLevel == 0 || level < c.MaxLevel) {
+		level++
+	}
+
+	if c.MaxLevel > 0 && level > c.MaxLevel {
+		return c.MaxLevel
+	}
+	return level
+}
+
+// Progress returns the XP earned within the current level and the XP the level spans.
+// Both are 0 at the max level
+func (c Curve) Progress(total int) (current, span int) {
+	level := c.Level(total)
+	if c.MaxLevel > 0 && level >= c.MaxLevel {
+		return 0, 0
+	}
+	start := c.Threshold(level)
+	return total - start, c.Threshold(level+1) - start
+}
+
+func (c Curve) valid() bool {
+	return c.Base > 0 && c.Exponent > 0 && c.MaxLevel >= 0
+}
@@ -0,0 +1,161 @@
+package xp
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+)
+
+// Reasons reported in xp.awarded events
+const (
+	ReasonContribution   = "contribution"
+	ReasonLeetCodeEasy   = "leetcode.easy"
+	ReasonLeetCodeMedium = "leetcode.medium"
+	ReasonLeetCodeHard   = "leetcode.hard"
+	ReasonMonkeytypeTest = "monkeytype.test"
+)
+
+// Weights is the XP given per unit of activity. A zero weight disables the activity
+type Weights struct {
+	// Contribution is the XP per contribution of a today.contributed event, per source
+	Contribution map[events.Source]int `json:"contribution"`
+
+	LeetCodeEasy   int `json:"leetcode_easy"`
+	LeetCodeMedium int `json:"leetcode_medium"`
+	LeetCodeHard   int `json:"leetcode_hard"`
+
+	MonkeytypeTest int `json:"monkeytype_test"`
+}
+
+// DefaultWeights leaves LeetCode and Monkeytype contributions at 0: solved problems and
+// finished tests are already rewarded by their own weights
+func DefaultWeights() Weights {
+	return Weights{
+		Contribution: map[events.Source]int{
+			events.SourceGithub:     10,
+			events.SourceCodeforces: 15,
+			events.SourceCodewars:   10,
+		},
+		LeetCodeEasy:   10,
+		LeetCodeMedium: 25,
+		LeetCodeHard:   60,
+		MonkeytypeTest: 2,
+	}
+}
+
+func (w Weights) isZero() bool {
+	return len(w.Contribution) == 0 && w.LeetCodeEasy == 0 && w.LeetCodeMedium == 0 &&
+		w.LeetCodeHard == 0 && w.MonkeytypeTest == 0
+}
+
+type Config struct {
+	// Weights default to DefaultWeights when left empty
+	Weights Weights
+	// Curve defaults to DefaultCurve when left empty
+	Curve Curve
+}
+
+// Award is XP earned by one activity, not yet added to the total of the user
+type Award struct {
+	Source events.Source
+	Reason string
+	Amount int
+}
+
+// Calculator turns activity events into XP and levels the same way for every service.
+// It keeps no state: the caller stores the XP total and the last seen activity per user
+type Calculator struct {
+	config Config
+}
+
+func NewCalculator(cfg Config) *Calculator {
+	if cfg.Weights.isZero() {
+		cfg.Weights = DefaultWeights()
+	}
+	if !cfg.Curve.valid() {
+		cfg.Curve = DefaultCurve
+	}
+	return &Calculator{config: cfg}
+}
+
+// Curve returns the level curve in use
+func (c *Calculator) Curve() Curve {
+	return c.config.Curve
+}
+
+// Contribution rewards a today.contributed event. Counts are running totals per source
+// and day, so only the increase over previous, the last count seen for the same source
+// and date, is rewarded
+func (c *Calculator) Contribution(e events.TodayContributedEvent, previous int) []Award {
+	return c.award(nil, e.Source, ReasonContribution, e.Count-previous, c.config.Weights.Contribution[e.Source])
+}
+
+// LeetCode rewards the problems solved since the previous profile, per difficulty. A nil
+// previous profile, or one of another LeetCode account, is a baseline and earns nothing,
+// so binding an account does not pay out its whole history
+func (c *Calculator) LeetCode(previous *events.LeetCodeProfileUpdated, current events.LeetCodeProfileUpdated) []Award {
+	if previous == nil || previous.LeetCodeUsername != current.LeetCodeUsername {
+		return nil
+	}
+
+	w := c.config.Weights
+	var out []Award
+	out = c.award(out, events.SourceLeetcode, ReasonLeetCodeEasy, current.EasySolved-previous.EasySolved, w.LeetCodeEasy)
+	out = c.award(out, events.SourceLeetcode, ReasonLeetCodeMedium, current.MediumSolved-previous.MediumSolved, w.LeetCodeMedium)
+	out = c.award(out, events.SourceLeetcode, ReasonLeetCodeHard, current.HardSolved-previous.HardSolved, w.LeetCodeHard)
+	return out
+}
+
+// Monkeytype rewards the tests completed since the previous profile. Baselines work as in
+// LeetCode
+func (c *Calculator) Monkeytype(previous *events.MonkeytypeProfileUpdated, current events.MonkeytypeProfileUpdated) []Award {
+	if previous == nil || previous.MonkeytypeUsername != current.MonkeytypeUsername {
+		return nil
+	}
+	return c.award(nil, events.SourceMonkeytype, ReasonMonkeytypeTest, current.TestsCompleted-previous.TestsCompleted, c.config.Weights.MonkeytypeTest)
+}
+
+// Apply adds the awards to the total XP of the user. It returns the new total with an
+// xp.awarded event per award and a level.up event when the level went up
+func (c *Calculator) Apply(userID uuid.UUID, total int, awards []Award, at time.Time) (int, []interface{}) {
+	curve := c.config.Curve
+	startLevel := curve.Level(total)
+
+	var out []interface{}
+	for _, a := range awards {
+		if a.Amount <= 0 {
+			continue
+		}
+		total += a.Amount
+		out = append(out, events.XPAwarded{
+			UserID:    userID,
+			Source:    a.Source,
+			Reason:    a.Reason,
+			Amount:    a.Amount,
+			TotalXP:   total,
+			Level:     curve.Level(total),
+			AwardedAt: at,
+		})
+	}
+
+	if level := curve.Level(total); level > startLevel {
+		out = append(out, events.LevelUp{
+			UserID:        userID,
+			Level:         level,
+			PreviousLevel: startLevel,
+			TotalXP:       total,
+			ReachedAt:     at,
+		})
+	}
+	return total, out
+}
+
+// award appends an award for a positive delta. Decreases, e.g. after an account was
+// reset, never take XP back
+func (c *Calculator) award(out []Award, source events.Source, reason string, delta, weight int) []Award {
+	if delta <= 0 || weight <= 0 {
+		return out
+	}
+	return append(out, Award{Source: source, Reason: reason, Amount: delta * weight})
+}
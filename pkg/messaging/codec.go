@@ -0,0 +1,341 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+	eventspb "github.com/metacode-dream-team/MetaCode/pkg/pb/events"
+	"github.com/metacode-dream-team/MetaCode/pkg/pii"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	HeaderContentType = "content-type"
+	// HeaderIdempotencyKey identifies a logical event across redeliveries, see WithIdempotencyKey
+	HeaderIdempotencyKey = "idempotency-key"
+
+	ContentTypeJSON           = "application/json"
+	ContentTypeProtobuf       = "application/x-protobuf"
+	ContentTypeCloudEventJSON = "application/cloudevents+json"
+
+	// protoPackage holds a message per registered payload, named after the Go struct
+	protoPackage = "metacode.events.v1"
+
+	// Kafka protocol binding of CloudEvents: binary mode carries attributes in ce_ headers
+	headerCloudEventPrefix = "ce_"
+)
+
+// Envelope selects how an event and its metadata are laid out in a Kafka message
+type Envelope string
+
+const (
+	// EnvelopeLegacy is the {type,data} JSON object or the protobuf Envelope message
+	EnvelopeLegacy Envelope = ""
+	// EnvelopeCloudEventsStructured puts the whole CloudEvent into the message value as JSON
+	EnvelopeCloudEventsStructured Envelope = "cloudevents-structured"
+	// EnvelopeCloudEventsBinary keeps the payload as the value and the attributes in ce_ headers
+	EnvelopeCloudEventsBinary Envelope = "cloudevents-binary"
+)
+
+type idempotencyKeyCtx struct{}
+
+// WithIdempotencyKey makes producers attach key as the idempotency-key header of the
+// message, so consumers can drop duplicates of an event published more than once
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx{}, key)
+}
+
+// IdempotencyKeyOf returns the idempotency-key header of a message, empty when unset
+func IdempotencyKeyOf(msg *kafka.Message) string {
+	return headerValue(msg, HeaderIdempotencyKey)
+}
+
+// encoding describes how producers write events
+type encoding struct {
+	contentType string
+	envelope    Envelope
+	// source is the CloudEvents source attribute
+	source string
+	// cipher encrypts the sensitive payload fields when set
+	cipher *pii.Cipher
+}
+
+// encodeEvent validates the payload, encrypts its sensitive fields and encodes it in the
+// requested content type and envelope. The returned headers must be attached to the Kafka message
+func encodeEvent(ctx context.Context, enc encoding, eventType string, data interface{}) ([]byte, []kafka.Header, error) {
+	if enc.contentType == "" {
+		enc.contentType = ContentTypeJSON
+	}
+	if err := events.Validate(data); err != nil {
+		return nil, nil, fmt.Errorf("event %s rejected: %w", eventType, err)
+	}
+
+	// Encryption happens after validation, which needs the plaintext
+	if enc.cipher != nil {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("marshal event failed: %w", err)
+		}
+		if data, err = enc.cipher.Encrypt(ctx, eventType, raw); err != nil {
+			return nil, nil, fmt.Errorf("encrypt event %s failed: %w", eventType, err)
+		}
+	}
+
+	payload, err := encodePayload(enc.contentType, eventType, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value, headers, err := encodeEnvelope(enc, eventType, data, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	if key, _ := ctx.Value(idempotencyKeyCtx{}).(string); key != "" {
+		headers = append(headers, kafka.Header{Key: HeaderIdempotencyKey, Value: []byte(key)})
+	}
+	return value, headers, nil
+}
+
+// encodeEnvelope lays out the encoded payload in the requested envelope
+func encodeEnvelope(enc encoding, eventType string, data interface{}, payload []byte) ([]byte, []kafka.Header, error) {
+	switch enc.envelope {
+	case EnvelopeLegacy:
+		value, err := marshalLegacy(enc.contentType, eventType, payload)
+		return value, contentTypeHeader(enc.contentType), err
+	case EnvelopeCloudEventsStructured:
+		ce := newCloudEvent(enc, eventType, data, payload)
+		value, err := json.Marshal(ce)
+		if err != nil {
+			return nil, nil, fmt.Errorf("marshal cloud event failed: %w", err)
+		}
+		return value, contentTypeHeader(ContentTypeCloudEventJSON), nil
+	case EnvelopeCloudEventsBinary:
+		ce := newCloudEvent(enc, eventType, data, payload)
+		headers := append(contentTypeHeader(enc.contentType),
+			kafka.Header{Key: headerCloudEventPrefix + "specversion", Value: []byte(ce.SpecVersion)},
+			kafka.Header{Key: headerCloudEventPrefix + "id", Value: []byte(ce.ID)},
+			kafka.Header{Key: headerCloudEventPrefix + "source", Value: []byte(ce.Source)},
+			kafka.Header{Key: headerCloudEventPrefix + "type", Value: []byte(ce.Type)},
+			kafka.Header{Key: headerCloudEventPrefix + "time", Value: []byte(ce.Time.Format(time.RFC3339Nano))},
+		)
+		if ce.Subject != "" {
+			headers = append(headers, kafka.Header{Key: headerCloudEventPrefix + "subject", Value: []byte(ce.Subject)})
+		}
+		return payload, headers, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported envelope %q", enc.envelope)
+}
+
+// encodePayload encodes the bare payload: JSON as is, protobuf as the message of the event type
+func encodePayload(contentType, eventType string, data interface{}) ([]byte, error) {
+	switch contentType {
+	case ContentTypeJSON:
+		payload, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("marshal event failed: %w", err)
+		}
+		return payload, nil
+	case ContentTypeProtobuf:
+		return marshalProtoPayload(eventType, data)
+	}
+	return nil, fmt.Errorf("unsupported content type %q", contentType)
+}
+
+// marshalLegacy wraps an encoded payload into the {type,data} object or the protobuf Envelope
+func marshalLegacy(contentType, eventType string, payload []byte) ([]byte, error) {
+	if contentType == ContentTypeProtobuf {
+		value, err := proto.Marshal(&eventspb.Envelope{Type: eventType, Data: payload})
+		if err != nil {
+			return nil, fmt.Errorf("marshal protobuf envelope failed: %w", err)
+		}
+		return value, nil
+	}
+
+	value, err := json.Marshal(events.Event{Type: eventType, Data: payload})
+	if err != nil {
+		return nil, fmt.Errorf("marshal event failed: %w", err)
+	}
+	return value, nil
+}
+
+func newCloudEvent(enc encoding, eventType string, data interface{}, payload []byte) events.CloudEvent {
+	ce := events.NewCloudEvent(enc.source, events.Event{Type: eventType})
+	ce.DataContentType = enc.contentType
+	if userID, ok := events.UserIDOf(data); ok {
+		ce.Subject = userID.String()
+	}
+	if enc.contentType == ContentTypeJSON {
+		ce.Data = payload
+	} else {
+		ce.DataBase64 = payload
+	}
+	return ce
+}
+
+func contentTypeHeader(contentType string) []kafka.Header {
+	return []kafka.Header{{Key: HeaderContentType, Value: []byte(contentType)}}
+}
+
+// decodeEvent reads a Kafka message in any supported content type and envelope. The payload
+// is always handed out as JSON so handlers do not depend on the wire format
+func decodeEvent(msg *kafka.Message) (events.Event, error) {
+	var event events.Event
+	contentType := mediaType(headerValue(msg, HeaderContentType))
+
+	// Binary CloudEvents are recognised by their mandatory specversion header
+	if headerValue(msg, headerCloudEventPrefix+"specversion") != "" {
+		eventType := headerValue(msg, headerCloudEventPrefix+"type")
+		if eventType == "" {
+			return event, fmt.Errorf("cloud event without %stype header", headerCloudEventPrefix)
+		}
+		if contentType == "" {
+			contentType = ContentTypeJSON
+		}
+		data, err := decodePayload(contentType, eventType, msg.Value)
+		if err != nil {
+			return event, err
+		}
+		return events.Event{Type: eventType, Data: data}, nil
+	}
+
+	switch contentType {
+	case "", ContentTypeJSON:
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return event, fmt.Errorf("unmarshal event failed: %w", err)
+		}
+	case ContentTypeProtobuf:
+		var envelope eventspb.Envelope
+		if err := proto.Unmarshal(msg.Value, &envelope); err != nil {
+			return event, fmt.Errorf("unmarshal protobuf envelope failed: %w", err)
+		}
+		data, err := protoToJSON(envelope.GetType(), envelope.GetData())
+		if err != nil {
+			return event, err
+		}
+		event.Type = envelope.GetType()
+		event.Data = data
+	case ContentTypeCloudEventJSON:
+		var ce events.CloudEvent
+		if err := json.Unmarshal(msg.Value, &ce); err != nil {
+			return event, fmt.Errorf("unmarshal cloud event failed: %w", err)
+		}
+		dataContentType := mediaType(ce.DataContentType)
+		payload := []byte(ce.Data)
+		if ce.DataBase64 != nil {
+			payload = ce.DataBase64
+		}
+		if dataContentType == "" {
+			dataContentType = ContentTypeJSON
+		}
+		data, err := decodePayload(dataContentType, ce.Type, payload)
+		if err != nil {
+			return event, err
+		}
+		event.Type = ce.Type
+		event.Data = data
+	default:
+		return event, fmt.Errorf("unsupported content type %q", contentType)
+	}
+
+	return event, nil
+}
+
+// DecodeMessage reads a Kafka message written by any producer of this package, for
+// consumers that manage their own partitions (see projection.Runner)
+func DecodeMessage(msg *kafka.Message) (events.Event, error) {
+	return decodeEvent(msg)
+}
+
+// decodePayload turns a bare payload into JSON
+func decodePayload(contentType, eventType string, payload []byte) (json.RawMessage, error) {
+	switch contentType {
+	case ContentTypeJSON:
+		return payload, nil
+	case ContentTypeProtobuf:
+		return protoToJSON(eventType, payload)
+	}
+	return nil, fmt.Errorf("unsupported data content type %q", contentType)
+}
+
+// mediaType strips parameters such as charset from a content type
+func mediaType(contentType string) string {
+	if contentType == "" {
+		return ""
+	}
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return contentType
+	}
+	return mt
+}
+
+// marshalProtoPayload converts the payload into its protobuf message through protojson,
+// which shares field names with the JSON tags of the payload structs
+func marshalProtoPayload(eventType string, data interface{}) ([]byte, error) {
+	msg, err := newProtoPayload(eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("marshal event failed: %w", err)
+	}
+	if err := protojson.Unmarshal(raw, msg); err != nil {
+		return nil, fmt.Errorf("convert %s to protobuf failed: %w", eventType, err)
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("marshal protobuf payload failed: %w", err)
+	}
+	return payload, nil
+}
+
+func protoToJSON(eventType string, payload []byte) (json.RawMessage, error) {
+	msg, err := newProtoPayload(eventType)
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(payload, msg); err != nil {
+		return nil, fmt.Errorf("unmarshal %s protobuf payload failed: %w", eventType, err)
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("convert %s from protobuf failed: %w", eventType, err)
+	}
+	return data, nil
+}
+
+// newProtoPayload finds the protobuf message named after the registered payload struct
+func newProtoPayload(eventType string) (proto.Message, error) {
+	d, ok := events.Lookup(eventType)
+	if !ok {
+		return nil, fmt.Errorf("unknown event type %s", eventType)
+	}
+
+	name := protoreflect.FullName(protoPackage + "." + d.Payload.Name())
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("no protobuf message %s for event %s: %w", name, eventType, err)
+	}
+	return mt.New().Interface(), nil
+}
+
+func headerValue(msg *kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
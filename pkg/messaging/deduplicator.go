@@ -0,0 +1,20 @@
+package messaging
+
+import "context"
+
+// Deduplicator remembers the idempotency keys of processed events (see WithIdempotencyKey),
+// so an event published more than once is handled once
+type Deduplicator interface {
+	// Reserve claims the key before the event is handled. It returns nil when the event was
+	// handled already or is being handled right now. A reservation that is neither
+	// confirmed nor released expires on its own, so a crashed consumer does not hold it
+	Reserve(ctx context.Context, key string) (Reservation, error)
+}
+
+// Reservation is held while the event of an idempotency key is being handled
+type Reservation interface {
+	// Confirm marks the event as handled, after the handler succeeded
+	Confirm(ctx context.Context) error
+	// Release gives the key up after the handler failed, so a redelivery is handled again
+	Release(ctx context.Context) error
+}
@@ -0,0 +1,81 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+)
+
+// DualProducer publishes integration account events in both the per-source and the
+// integration.account.* form while consumers migrate. Whichever form is produced, the
+// other one follows right after it; all other events pass through unchanged
+type DualProducer struct {
+	producer Producer
+}
+
+// Ensure DualProducer implements Producer
+var _ Producer = (*DualProducer)(nil)
+
+func NewDualProducer(producer Producer) *DualProducer {
+	return &DualProducer{producer: producer}
+}
+
+// Produce publishes the event and then its counterpart. Both are validated first, so an
+// event whose counterpart would be rejected is not published at all. An error producing
+// the counterpart is returned even though the event itself was already published
+func (p *DualProducer) Produce(ctx context.Context, eventType string, data interface{}) error {
+	payload, err := payloadOf(eventType, data)
+	if err != nil {
+		return err
+	}
+
+	counterpartType, counterpart, ok := events.UnifiedAccountEvent(payload)
+	if !ok {
+		counterpartType, counterpart, ok = events.LegacyAccountEvent(payload)
+	}
+	if ok {
+		if err := events.Validate(payload); err != nil {
+			return fmt.Errorf("event %s rejected: %w", eventType, err)
+		}
+		if err := events.Validate(counterpart); err != nil {
+			return fmt.Errorf("event %s rejected, its counterpart %s is invalid: %w", eventType, counterpartType, err)
+		}
+	}
+
+	if err := p.producer.Produce(ctx, eventType, data); err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	if err := p.producer.Produce(ctx, counterpartType, counterpart); err != nil {
+		return fmt.Errorf("failed to produce %s for %s: %w", counterpartType, eventType, err)
+	}
+	return nil
+}
+
+func (p *DualProducer) Close() {
+	p.producer.Close()
+}
+
+// payloadOf decodes account event payloads handed over as JSON. Other events are neither
+// decoded nor validated here, nil means there is no counterpart
+func payloadOf(eventType string, data interface{}) (interface{}, error) {
+	if !events.IsAccountEvent(eventType) {
+		return nil, nil
+	}
+
+	var raw json.RawMessage
+	switch d := data.(type) {
+	case json.RawMessage:
+		raw = d
+	case []byte:
+		raw = d
+	default:
+		return data, nil
+	}
+	return events.Decode(eventType, raw)
+}
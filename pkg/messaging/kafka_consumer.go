@@ -12,6 +12,7 @@ import (
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
 	"github.com/metacode-dream-team/MetaCode/pkg/events"
+	"github.com/metacode-dream-team/MetaCode/pkg/pii"
 )
 
 type EventHandler func(json.RawMessage) error
@@ -29,6 +30,37 @@ type ConsumerConfig struct {
 	ReadTimeout int
 	// ErrorBackoff defines pause duration after a non-timeout error
 	ErrorBackoff time.Duration
+	// FailureHandler receives the messages that could not be processed: undecodable
+	// messages (with the raw message value), payloads rejected by RejectInvalid (with the
+	// *events.ValidationError), events that could not be decrypted and events whose
+	// handler failed. Failures are only logged when it is nil
+	FailureHandler FailureHandler
+	// RejectInvalid validates payloads of registered event types before any handler sees
+	// them and hands invalid ones to FailureHandler. Off by default, since retained events
+	// written before a validation rule existed need not satisfy it
+	RejectInvalid bool
+	// Cipher decrypts the fields tagged `pii:"true"` before handlers see them. Events of
+	// users whose data key was shredded are skipped. Nil hands payloads out as they are
+	Cipher *pii.Cipher
+	// Deduplicator skips messages whose idempotency key (see WithIdempotencyKey) was
+	// handled already, e.g. RedisDeduplicator. A key counts as handled only once its
+	// handler succeeded. Messages are handled anyway when it fails. Nil disables it
+	Deduplicator Deduplicator
+}
+
+// FailureHandler is called with the event that could not be processed and the reason
+type FailureHandler func(eventType string, data json.RawMessage, err error)
+
+// HandlePayload adapts a typed handler: the payload is decoded into T before the handler
+// is called. Payloads that do not decode end up in the consumer failure path
+func HandlePayload[T any](handler func(T) error) EventHandler {
+	return func(data json.RawMessage) error {
+		var payload T
+		if err := json.Unmarshal(data, &payload); err != nil {
+			return fmt.Errorf("decode payload failed: %w", err)
+		}
+		return handler(payload)
+	}
 }
 
 type KafkaConsumer struct {
@@ -120,17 +152,17 @@ func (c *KafkaConsumer) Start(ctx context.Context) {
 			}
 		}
 
-		c.handleMessage(msg)
+		c.handleMessage(ctx, msg)
 	}
 }
 
-func (c *KafkaConsumer) handleMessage(msg *kafka.Message) {
-	var event events.Event
-
+func (c *KafkaConsumer) handleMessage(ctx context.Context, msg *kafka.Message) {
 	c.logInfo("Received message from topic %s", *msg.TopicPartition.Topic)
 
-	if err := json.Unmarshal(msg.Value, &event); err != nil {
-		c.logErr("Failed to unmarshal event: %v | Raw: %s", err, string(msg.Value))
+	event, err := decodeEvent(msg)
+	if err != nil {
+		c.logErr("Failed to decode event: %v | Raw: %s", err, string(msg.Value))
+		c.fail(event.Type, msg.Value, err)
 		return
 	}
 
@@ -140,10 +172,76 @@ func (c *KafkaConsumer) handleMessage(msg *kafka.Message) {
 		return
 	}
 
+	if c.config.Cipher != nil {
+		data, err := c.config.Cipher.Decrypt(ctx, event.Type, event.Data)
+		if errors.Is(err, pii.ErrKeyShredded) {
+			c.logInfo("Skipping event %s: %v", event.Type, err)
+			return
+		}
+		if err != nil {
+			c.logErr("Failed to decrypt event %s: %v", event.Type, err)
+			c.fail(event.Type, event.Data, err)
+			return
+		}
+		event.Data = data
+	}
+
+	// Registered payloads are validated on the plaintext before any handler sees them
+	if _, ok := events.Lookup(event.Type); ok && c.config.RejectInvalid {
+		if _, err := events.Decode(event.Type, event.Data); err != nil {
+			c.logErr("Rejected event %s: %v", event.Type, err)
+			c.fail(event.Type, event.Data, err)
+			return
+		}
+	}
+
+	reservation, ok := c.reserve(ctx, msg, event.Type)
+	if !ok {
+		return
+	}
+
 	if err := handler(event.Data); err != nil {
 		c.logErr("Handler failed for event %s: %v", event.Type, err)
-	} else {
-		c.logInfo("Successfully processed event: %s", event.Type)
+		if reservation != nil {
+			if err := reservation.Release(ctx); err != nil {
+				c.logErr("Failed to release idempotency key of event %s: %v", event.Type, err)
+			}
+		}
+		c.fail(event.Type, event.Data, err)
+		return
+	}
+
+	if reservation != nil {
+		if err := reservation.Confirm(ctx); err != nil {
+			c.logErr("Failed to confirm idempotency key of event %s: %v", event.Type, err)
+		}
+	}
+	c.logInfo("Successfully processed event: %s", event.Type)
+}
+
+// reserve claims the idempotency key of the message, if any. ok is false for duplicates,
+// which are skipped
+func (c *KafkaConsumer) reserve(ctx context.Context, msg *kafka.Message, eventType string) (Reservation, bool) {
+	key := IdempotencyKeyOf(msg)
+	if key == "" || c.config.Deduplicator == nil {
+		return nil, true
+	}
+
+	reservation, err := c.config.Deduplicator.Reserve(ctx, key)
+	if err != nil {
+		c.logErr("Failed to reserve idempotency key %s, processing event anyway: %v", key, err)
+		return nil, true
+	}
+	if reservation == nil {
+		c.logInfo("Skipping duplicate event %s (%s)", eventType, key)
+		return nil, false
+	}
+	return reservation, true
+}
+
+func (c *KafkaConsumer) fail(eventType string, data json.RawMessage, err error) {
+	if c.config.FailureHandler != nil {
+		c.config.FailureHandler(eventType, data, err)
 	}
 }
 
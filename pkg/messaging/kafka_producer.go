@@ -2,30 +2,127 @@ package messaging
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/metacode-dream-team/MetaCode/pkg/pii"
 )
 
+type ProducerConfig struct {
+	BootstrapServers string
+	Topic            string
+	EnableLogging    bool
+	LogOutput        io.Writer
+	ErrOutput        io.Writer
+
+	// ContentType selects the payload encoding: ContentTypeJSON (default) or ContentTypeProtobuf
+	ContentType string
+	// Envelope selects the message layout: EnvelopeLegacy (default) or one of the CloudEvents modes
+	Envelope Envelope
+	// EventSource is the CloudEvents source attribute, e.g. "/services/integration".
+	// Default is "/metacode/<topic>"
+	EventSource string
+	// Cipher encrypts the fields tagged `pii:"true"` with the data key of the user.
+	// Nil sends them in plaintext
+	Cipher *pii.Cipher
+	// Spool enables the on-disk spool for messages that cannot be delivered.
+	// Nil keeps messages in the librdkafka queue only.
+	//
+	// Messages spooled by Produce keep their order: while anything is spooled, new
+	// messages queue up behind it. Messages librdkafka accepted and later gave up on are
+	// spooled behind those produced in the meantime, so their order, also per key, is lost
+	Spool *SpoolConfig
+	// DeliveryTimeout defines how long librdkafka retries a message before reporting it
+	// as failed. Default is 30s with a spool, librdkafka's default otherwise
+	DeliveryTimeout time.Duration
+}
+
 type KafkaProducer struct {
+	config   ProducerConfig
 	producer *kafka.Producer
 	topic    string
+	spool    *FileSpool
+	stop     context.CancelFunc
+	done     chan struct{}
+	// mu serializes Produce with spooling and replay, so no message overtakes the spool
+	mu        sync.Mutex
+	logger    *log.Logger
+	errLogger *log.Logger
 }
 
+// Ensure KafkaProducer implements Producer
+var _ Producer = (*KafkaProducer)(nil)
+
 // NewKafkaProducer creates a new KafkaProducer instance
 func NewKafkaProducer(brokers, topic string) (*KafkaProducer, error) {
-	p, err := kafka.NewProducer(&kafka.ConfigMap{
-		"bootstrap.servers": brokers,
+	return NewKafkaProducerWithConfig(ProducerConfig{
+		BootstrapServers: brokers,
+		Topic:            topic,
 	})
+}
+
+// NewKafkaProducerWithConfig creates a new KafkaProducer instance with optional features enabled
+func NewKafkaProducerWithConfig(cfg ProducerConfig) (*KafkaProducer, error) {
+	if cfg.LogOutput == nil {
+		cfg.LogOutput = os.Stdout
+	}
+	if cfg.ErrOutput == nil {
+		cfg.ErrOutput = os.Stderr
+	}
+	if cfg.EventSource == "" {
+		cfg.EventSource = "/metacode/" + cfg.Topic
+	}
+	if cfg.Spool != nil && cfg.DeliveryTimeout <= 0 {
+		cfg.DeliveryTimeout = 30 * time.Second
+	}
+
+	kafkaConfig := &kafka.ConfigMap{
+		"bootstrap.servers": cfg.BootstrapServers,
+	}
+	if cfg.DeliveryTimeout > 0 {
+		_ = kafkaConfig.SetKey("message.timeout.ms", int(cfg.DeliveryTimeout.Milliseconds()))
+	}
+
+	var spool *FileSpool
+	if cfg.Spool != nil {
+		var err error
+		if spool, err = OpenFileSpool(*cfg.Spool); err != nil {
+			return nil, fmt.Errorf("failed to open producer spool: %w", err)
+		}
+		cfg.Spool = &spool.config
+	}
+
+	p, err := kafka.NewProducer(kafkaConfig)
 	if err != nil {
+		if spool != nil {
+			_ = spool.Close()
+		}
 		return nil, fmt.Errorf("failed to create Kafka producer: %w", err)
 	}
 
-	return &KafkaProducer{
-		producer: p,
-		topic:    topic,
-	}, nil
+	producer := &KafkaProducer{
+		config:    cfg,
+		producer:  p,
+		topic:     cfg.Topic,
+		spool:     spool,
+		logger:    log.New(cfg.LogOutput, "[KAFKA_PRODUCER] INFO: ", log.LstdFlags),
+		errLogger: log.New(cfg.ErrOutput, "[KAFKA_PRODUCER] ERROR: ", log.LstdFlags),
+	}
+
+	if spool != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		producer.stop = cancel
+		producer.done = make(chan struct{}, 2)
+		go producer.watchDeliveries()
+		go producer.replayLoop(ctx)
+	}
+
+	return producer, nil
 }
 
 func (p *KafkaProducer) Produce(ctx context.Context, eventType string, data interface{}) error {
@@ -35,31 +132,181 @@ func (p *KafkaProducer) Produce(ctx context.Context, eventType string, data inte
 	default:
 	}
 
-	event := struct {
-		Type string      `json:"type"`
-		Data interface{} `json:"data"`
-	}{
-		Type: eventType,
-		Data: data,
+	value, headers, err := encodeEvent(ctx, encoding{
+		contentType: p.config.ContentType,
+		envelope:    p.config.Envelope,
+		source:      p.config.EventSource,
+		cipher:      p.config.Cipher,
+	}, eventType, data)
+	if err != nil {
+		return err
 	}
 
-	jsonData, err := json.Marshal(event)
-	if err != nil {
-		return fmt.Errorf("marshal event failed: %w", err)
+	msg := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &p.topic, Partition: kafka.PartitionAny},
+		Value:          value,
+		Headers:        headers,
+	}
+
+	if p.spool == nil {
+		if err := p.producer.Produce(msg, nil); err != nil {
+			return fmt.Errorf("produce message failed: %w", err)
+		}
+		return nil
+	}
+
+	// Keep ordering: while anything is spooled, new messages queue up behind it. Replay
+	// holds the lock, so Produce waits while spooled messages are being resent
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.spool.Stats().Messages > 0 {
+		return p.spoolMessage(msg)
+	}
+
+	if err := p.producer.Produce(msg, nil); err != nil {
+		p.logErr("Produce failed, spooling message: %v", err)
+		return p.spoolMessage(msg)
+	}
+
+	return nil
+}
+
+// SpoolStats reports the spool depth. It is zero when the spool is disabled
+func (p *KafkaProducer) SpoolStats() SpoolStats {
+	if p.spool == nil {
+		return SpoolStats{}
+	}
+	return p.spool.Stats()
+}
+
+// watchDeliveries spools messages librdkafka gave up on
+func (p *KafkaProducer) watchDeliveries() {
+	defer func() { p.done <- struct{}{} }()
+
+	for e := range p.producer.Events() {
+		switch ev := e.(type) {
+		case *kafka.Message:
+			if ev.TopicPartition.Error == nil {
+				continue
+			}
+			p.logErr("Delivery failed, spooling message: %v", ev.TopicPartition.Error)
+			p.mu.Lock()
+			err := p.spoolMessage(ev)
+			p.mu.Unlock()
+			if err != nil {
+				p.logErr("Message lost: %v", err)
+			}
+		case kafka.Error:
+			p.logErr("Producer error: %v", ev)
+		}
+	}
+}
+
+// replayLoop resends spooled messages in order once the broker is reachable
+func (p *KafkaProducer) replayLoop(ctx context.Context) {
+	defer func() { p.done <- struct{}{} }()
+
+	ticker := time.NewTicker(p.config.Spool.ReplayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		stats := p.spool.Stats()
+		if stats.Messages == 0 {
+			continue
+		}
+
+		if _, err := p.producer.GetMetadata(&p.topic, false, 5000); err != nil {
+			p.logErr("Broker unavailable, %d message(s) spooled: %v", stats.Messages, err)
+			continue
+		}
+
+		p.logInfo("Replaying %d spooled message(s)", stats.Messages)
+		p.mu.Lock()
+		err := p.spool.Replay(func(rec SpoolRecord) error { return p.deliver(ctx, rec) })
+		p.mu.Unlock()
+		if err != nil {
+			p.logErr("Spool replay interrupted: %v", err)
+		}
 	}
+}
 
-	err = p.producer.Produce(&kafka.Message{
+// deliver produces a spooled record and waits for the broker acknowledgement
+func (p *KafkaProducer) deliver(ctx context.Context, rec SpoolRecord) error {
+	msg := &kafka.Message{
 		TopicPartition: kafka.TopicPartition{Topic: &p.topic, Partition: kafka.PartitionAny},
-		Value:          jsonData,
-	}, nil)
-	if err != nil {
+		Key:            rec.Key,
+		Value:          rec.Value,
+	}
+	for _, h := range rec.Headers {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: h.Key, Value: h.Value})
+	}
+
+	report := make(chan kafka.Event, 1)
+	if err := p.producer.Produce(msg, report); err != nil {
 		return fmt.Errorf("produce message failed: %w", err)
 	}
 
+	select {
+	case e := <-report:
+		if m, ok := e.(*kafka.Message); ok && m.TopicPartition.Error != nil {
+			return fmt.Errorf("delivery failed: %w", m.TopicPartition.Error)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *KafkaProducer) spoolMessage(msg *kafka.Message) error {
+	rec := SpoolRecord{Key: msg.Key, Value: msg.Value}
+	for _, h := range msg.Headers {
+		rec.Headers = append(rec.Headers, SpoolHeader{Key: h.Key, Value: h.Value})
+	}
+
+	if err := p.spool.Append(rec); err != nil {
+		return fmt.Errorf("spool message failed: %w", err)
+	}
 	return nil
 }
 
+func (p *KafkaProducer) logInfo(format string, v ...interface{}) {
+	if p.config.EnableLogging {
+		p.logger.Printf(format, v...)
+	}
+}
+
+func (p *KafkaProducer) logErr(format string, v ...interface{}) {
+	if p.config.EnableLogging {
+		p.errLogger.Printf(format, v...)
+	}
+}
+
 func (p *KafkaProducer) Close() {
-	p.producer.Flush(5000)
+	if p.spool == nil {
+		p.producer.Flush(5000)
+		p.producer.Close()
+		return
+	}
+
+	p.stop()
+	<-p.done
+
+	// Whatever is still queued or awaiting an acknowledgement goes to the spool instead of
+	// being dropped. Purging in-flight requests fails their delivery reports even if the
+	// broker got them, so those messages may be sent twice
+	if remaining := p.producer.Flush(5000); remaining > 0 {
+		_ = p.producer.Purge(kafka.PurgeQueue | kafka.PurgeInFlight)
+		p.producer.Flush(1000)
+	}
 	p.producer.Close()
+	<-p.done
+
+	_ = p.spool.Close()
 }
@@ -0,0 +1,421 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+	"github.com/metacode-dream-team/MetaCode/pkg/pii"
+)
+
+// OutboundEvent is an event emitted by a TransformHandler
+type OutboundEvent struct {
+	Type string
+	Data interface{}
+}
+
+// TransformHandler turns a consumed event into the events to emit. Returning an error
+// aborts the transaction and the input event is processed again after ErrorBackoff, up to
+// MaxAttempts times.
+type TransformHandler func(ctx context.Context, data json.RawMessage) ([]OutboundEvent, error)
+
+type TransactionalConfig struct {
+	BootstrapServers string
+	GroupID          string
+	Topics           []string
+	// OutputTopic receives the events returned by handlers
+	OutputTopic string
+	// TransactionalID must be stable across restarts and unique per processor instance
+	TransactionalID string
+	// ContentType selects the output encoding: ContentTypeJSON (default) or ContentTypeProtobuf
+	ContentType string
+	// Envelope selects the output layout: EnvelopeLegacy (default) or one of the CloudEvents modes
+	Envelope Envelope
+	// EventSource is the CloudEvents source attribute. Default is "/metacode/<output topic>"
+	EventSource string
+	// Cipher decrypts the sensitive fields of consumed events and encrypts those of the
+	// output. Nil leaves payloads as they are
+	Cipher        *pii.Cipher
+	EnableLogging bool
+	LogOutput     io.Writer
+	ErrOutput     io.Writer
+
+	// ReadTimeout defines how long the consumer waits for a message (ms)
+	// Default is 1000ms to prevent CPU busy loops
+	ReadTimeout int
+	// ErrorBackoff defines pause duration after a failed transaction or read error
+	ErrorBackoff time.Duration
+	// OperationTimeout bounds transaction init, commit and abort calls. Default is 30s
+	OperationTimeout time.Duration
+	// MaxAttempts defines how often an event is transformed before it is given up: handed
+	// to FailureHandler and committed without output. Default is 5
+	MaxAttempts int
+	// FailureHandler receives the events that are skipped: undecodable messages, payloads
+	// rejected by RejectInvalid and events given up after MaxAttempts. Failures are only
+	// logged when it is nil
+	FailureHandler FailureHandler
+	// RejectInvalid skips payloads of registered event types that fail validation instead
+	// of transforming them. Off by default, since retained events written before a
+	// validation rule existed need not satisfy it
+	RejectInvalid bool
+}
+
+// transformError is a failure of the event itself rather than of the transaction
+type transformError struct {
+	eventType string
+	data      json.RawMessage
+	err       error
+}
+
+func (e *transformError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transformError) Unwrap() error {
+	return e.err
+}
+
+// TransactionalProcessor consumes events and produces the handler output in one Kafka
+// transaction together with the consumer offset, so every input event results in its
+// output events exactly once.
+type TransactionalProcessor struct {
+	config   TransactionalConfig
+	consumer *kafka.Consumer
+	producer *kafka.Producer
+	handlers map[string]TransformHandler
+	// failed is the message whose transform failed last, attempts how often in a row
+	failed    kafka.TopicPartition
+	attempts  int
+	logger    *log.Logger
+	errLogger *log.Logger
+}
+
+// Ensure TransactionalProcessor implements Consumer
+var _ Consumer = (*TransactionalProcessor)(nil)
+
+// NewTransactionalProcessor creates the consumer and transactional producer pair
+func NewTransactionalProcessor(cfg TransactionalConfig) (*TransactionalProcessor, error) {
+	if cfg.OutputTopic == "" || cfg.TransactionalID == "" {
+		return nil, errors.New("missing output topic or transactional id")
+	}
+	if cfg.LogOutput == nil {
+		cfg.LogOutput = os.Stdout
+	}
+	if cfg.ErrOutput == nil {
+		cfg.ErrOutput = os.Stderr
+	}
+	if cfg.ReadTimeout <= 0 {
+		cfg.ReadTimeout = 1000
+	}
+	if cfg.ErrorBackoff <= 0 {
+		cfg.ErrorBackoff = 2 * time.Second
+	}
+	if cfg.OperationTimeout <= 0 {
+		cfg.OperationTimeout = 30 * time.Second
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 5
+	}
+	if cfg.EventSource == "" {
+		cfg.EventSource = "/metacode/" + cfg.OutputTopic
+	}
+
+	consumerConfig := &kafka.ConfigMap{
+		"bootstrap.servers":     cfg.BootstrapServers,
+		"group.id":              cfg.GroupID,
+		"auto.offset.reset":     "earliest",
+		"broker.address.family": "v4",
+		"enable.auto.commit":    false,
+		"isolation.level":       "read_committed",
+	}
+
+	producerConfig := &kafka.ConfigMap{
+		"bootstrap.servers":   cfg.BootstrapServers,
+		"transactional.id":    cfg.TransactionalID,
+		"enable.idempotence":  true,
+		"go.delivery.reports": false,
+	}
+
+	if !cfg.EnableLogging {
+		_ = consumerConfig.SetKey("log_level", 0)
+		_ = producerConfig.SetKey("log_level", 0)
+	}
+
+	c, err := kafka.NewConsumer(consumerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka consumer: %w", err)
+	}
+
+	p, err := kafka.NewProducer(producerConfig)
+	if err != nil {
+		_ = c.Close()
+		return nil, fmt.Errorf("failed to create Kafka producer: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.OperationTimeout)
+	defer cancel()
+
+	if err := p.InitTransactions(ctx); err != nil {
+		p.Close()
+		_ = c.Close()
+		return nil, fmt.Errorf("failed to init transactions: %w", err)
+	}
+
+	return &TransactionalProcessor{
+		config:    cfg,
+		consumer:  c,
+		producer:  p,
+		handlers:  make(map[string]TransformHandler),
+		logger:    log.New(cfg.LogOutput, "[KAFKA_TRANSACTIONAL] INFO: ", log.LstdFlags),
+		errLogger: log.New(cfg.ErrOutput, "[KAFKA_TRANSACTIONAL] ERROR: ", log.LstdFlags),
+	}, nil
+}
+
+func (p *TransactionalProcessor) RegisterHandler(eventType string, handler TransformHandler) {
+	p.handlers[eventType] = handler
+}
+
+func (p *TransactionalProcessor) Start(ctx context.Context) {
+	if err := p.consumer.SubscribeTopics(p.config.Topics, nil); err != nil {
+		p.logErr("Failed to subscribe to topics: %v", err)
+		return
+	}
+
+	p.logInfo("Transactional processor started. Subscribed to: %v", p.config.Topics)
+
+	for {
+		if ctx.Err() != nil {
+			p.logInfo("Context cancelled, stopping processor...")
+			return
+		}
+
+		msg, err := p.consumer.ReadMessage(time.Duration(p.config.ReadTimeout) * time.Millisecond)
+		if err != nil {
+			var kafkaErr kafka.Error
+			if errors.As(err, &kafkaErr) && kafkaErr.Code() == kafka.ErrTimedOut {
+				continue
+			}
+
+			p.logErr("Message read error: %v. Retrying in %v...", err, p.config.ErrorBackoff)
+			if !p.backoff(ctx) {
+				return
+			}
+			continue
+		}
+
+		if err := p.process(ctx, msg); err != nil {
+			var kafkaErr kafka.Error
+			if errors.As(err, &kafkaErr) && kafkaErr.IsFatal() {
+				p.logErr("Fatal transaction error, stopping processor: %v", err)
+				return
+			}
+
+			p.logErr("Transaction failed: %v. Retrying in %v...", err, p.config.ErrorBackoff)
+			p.rewind(msg.TopicPartition)
+			if !p.backoff(ctx) {
+				return
+			}
+		}
+	}
+}
+
+// process runs the handler and commits its output together with the next offset. An
+// event that keeps failing is given up after MaxAttempts
+func (p *TransactionalProcessor) process(ctx context.Context, msg *kafka.Message) error {
+	outputs, err := p.transform(ctx, msg)
+
+	var transformErr *transformError
+	if errors.As(err, &transformErr) {
+		if !p.giveUp(msg.TopicPartition) {
+			return err
+		}
+		p.logErr("Giving up on event %s after %d attempt(s): %v", transformErr.eventType, p.attempts, err)
+		p.fail(transformErr.eventType, transformErr.data, transformErr.err)
+		outputs, err = nil, nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return p.commit(ctx, msg, outputs)
+}
+
+// giveUp counts the failed attempt at the message and reports whether it was the last
+func (p *TransactionalProcessor) giveUp(tp kafka.TopicPartition) bool {
+	if p.attempts > 0 && *p.failed.Topic == *tp.Topic && p.failed.Partition == tp.Partition && p.failed.Offset == tp.Offset {
+		p.attempts++
+	} else {
+		p.failed = tp
+		p.attempts = 1
+	}
+	return p.attempts >= p.config.MaxAttempts
+}
+
+// commit produces the outputs and the next offset of msg in one transaction
+func (p *TransactionalProcessor) commit(ctx context.Context, msg *kafka.Message, outputs []OutboundEvent) error {
+	if err := p.producer.BeginTransaction(); err != nil {
+		return fmt.Errorf("begin transaction failed: %w", err)
+	}
+
+	if err := p.produceAll(ctx, outputs); err != nil {
+		p.abort()
+		return err
+	}
+
+	metadata, err := p.consumer.GetConsumerGroupMetadata()
+	if err != nil {
+		p.abort()
+		return fmt.Errorf("get consumer group metadata failed: %w", err)
+	}
+
+	next := msg.TopicPartition
+	next.Offset++
+
+	opCtx, cancel := context.WithTimeout(ctx, p.config.OperationTimeout)
+	defer cancel()
+
+	if err := p.producer.SendOffsetsToTransaction(opCtx, []kafka.TopicPartition{next}, metadata); err != nil {
+		p.abort()
+		return fmt.Errorf("send offsets to transaction failed: %w", err)
+	}
+
+	for {
+		err := p.producer.CommitTransaction(opCtx)
+		if err == nil {
+			return nil
+		}
+
+		var kafkaErr kafka.Error
+		if errors.As(err, &kafkaErr) && kafkaErr.IsRetriable() && opCtx.Err() == nil {
+			continue
+		}
+		if !errors.As(err, &kafkaErr) || !kafkaErr.IsFatal() {
+			p.abort()
+		}
+		return fmt.Errorf("commit transaction failed: %w", err)
+	}
+}
+
+func (p *TransactionalProcessor) transform(ctx context.Context, msg *kafka.Message) ([]OutboundEvent, error) {
+	event, err := decodeEvent(msg)
+	if err != nil {
+		// Poison messages are skipped: committing the offset without output
+		p.logErr("Failed to decode event: %v | Raw: %s", err, string(msg.Value))
+		p.fail(event.Type, msg.Value, err)
+		return nil, nil
+	}
+
+	handler, ok := p.handlers[event.Type]
+	if !ok {
+		return nil, nil
+	}
+
+	if p.config.Cipher != nil {
+		data, err := p.config.Cipher.Decrypt(ctx, event.Type, event.Data)
+		if errors.Is(err, pii.ErrKeyShredded) {
+			p.logInfo("Skipping event %s: %v", event.Type, err)
+			return nil, nil
+		}
+		if err != nil {
+			return nil, &transformError{event.Type, event.Data, fmt.Errorf("decrypt event %s failed: %w", event.Type, err)}
+		}
+		event.Data = data
+	}
+
+	// Registered payloads are validated on the plaintext, invalid ones are skipped
+	if _, ok := events.Lookup(event.Type); ok && p.config.RejectInvalid {
+		if _, err := events.Decode(event.Type, event.Data); err != nil {
+			p.logErr("Rejected event %s: %v", event.Type, err)
+			p.fail(event.Type, event.Data, err)
+			return nil, nil
+		}
+	}
+
+	outputs, err := handler(ctx, event.Data)
+	if err != nil {
+		return nil, &transformError{event.Type, event.Data, fmt.Errorf("handler failed for event %s: %w", event.Type, err)}
+	}
+
+	p.logInfo("Transformed event %s into %d event(s)", event.Type, len(outputs))
+	return outputs, nil
+}
+
+func (p *TransactionalProcessor) produceAll(ctx context.Context, outputs []OutboundEvent) error {
+	for _, out := range outputs {
+		value, headers, err := encodeEvent(ctx, encoding{
+			contentType: p.config.ContentType,
+			envelope:    p.config.Envelope,
+			source:      p.config.EventSource,
+			cipher:      p.config.Cipher,
+		}, out.Type, out.Data)
+		if err != nil {
+			return err
+		}
+
+		err = p.producer.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &p.config.OutputTopic, Partition: kafka.PartitionAny},
+			Value:          value,
+			Headers:        headers,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("produce message failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (p *TransactionalProcessor) fail(eventType string, data json.RawMessage, err error) {
+	if p.config.FailureHandler != nil {
+		p.config.FailureHandler(eventType, data, err)
+	}
+}
+
+func (p *TransactionalProcessor) abort() {
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.OperationTimeout)
+	defer cancel()
+
+	if err := p.producer.AbortTransaction(ctx); err != nil {
+		p.logErr("Abort transaction failed: %v", err)
+	}
+}
+
+// rewind moves the consumer back so the failed message is read again
+func (p *TransactionalProcessor) rewind(tp kafka.TopicPartition) {
+	if err := p.consumer.Seek(tp, p.config.ReadTimeout); err != nil {
+		p.logErr("Failed to rewind %s[%d] to offset %v: %v", *tp.Topic, tp.Partition, tp.Offset, err)
+	}
+}
+
+func (p *TransactionalProcessor) backoff(ctx context.Context) bool {
+	select {
+	case <-time.After(p.config.ErrorBackoff):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (p *TransactionalProcessor) logInfo(format string, v ...interface{}) {
+	if p.config.EnableLogging {
+		p.logger.Printf(format, v...)
+	}
+}
+
+func (p *TransactionalProcessor) logErr(format string, v ...interface{}) {
+	if p.config.EnableLogging {
+		p.errLogger.Printf(format, v...)
+	}
+}
+
+func (p *TransactionalProcessor) Close() {
+	p.logInfo("Closing transactional processor...")
+	p.producer.Close()
+	_ = p.consumer.Close()
+}
@@ -0,0 +1,117 @@
+package messaging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/metacode-dream-team/MetaCode/pkg/caching"
+	"github.com/redis/go-redis/v9"
+)
+
+// keyHandled replaces the reservation token once the event was handled
+const keyHandled = "handled"
+
+// confirmScript marks the key as handled unless another consumer reserved it after our
+// reservation expired
+var confirmScript = redis.NewScript(`
+local v = redis.call('GET', KEYS[1])
+if v and v ~= ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// releaseScript deletes the reservation while it still holds our token
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+type DeduplicatorConfig struct {
+	Redis caching.RedisConfig
+	// KeyPrefix namespaces the Redis keys. Default is "dedup"
+	KeyPrefix string
+	// ReserveTTL bounds how long a handler may take before another consumer may handle
+	// the same event. Default is 5m
+	ReserveTTL time.Duration
+	// TTL defines how long handled keys are remembered. It must cover the window in which
+	// duplicates arrive, e.g. the scheduler lease. Default is 24h
+	TTL time.Duration
+}
+
+// RedisDeduplicator keeps one key per idempotency key: the token of the consumer handling
+// the event while it is reserved, then a marker once it was handled
+type RedisDeduplicator struct {
+	config DeduplicatorConfig
+	client *redis.Client
+}
+
+// Ensure RedisDeduplicator implements Deduplicator
+var _ Deduplicator = (*RedisDeduplicator)(nil)
+
+func NewRedisDeduplicator(cfg DeduplicatorConfig) (*RedisDeduplicator, error) {
+	if cfg.KeyPrefix == "" {
+		cfg.KeyPrefix = "dedup"
+	}
+	if cfg.ReserveTTL <= 0 {
+		cfg.ReserveTTL = 5 * time.Minute
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = 24 * time.Hour
+	}
+
+	client, err := caching.NewRedisClient(cfg.Redis)
+	if err != nil {
+		return nil, err
+	}
+	return &RedisDeduplicator{config: cfg, client: client}, nil
+}
+
+func (d *RedisDeduplicator) Reserve(ctx context.Context, key string) (Reservation, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("failed to generate reservation token: %w", err)
+	}
+	r := &redisReservation{dedup: d, key: d.config.KeyPrefix + ":" + key, token: hex.EncodeToString(b)}
+
+	ok, err := d.client.SetNX(ctx, r.key, r.token, d.config.ReserveTTL).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key %s: %w", key, err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return r, nil
+}
+
+// Close gracefully closes Redis connection
+func (d *RedisDeduplicator) Close() error {
+	return d.client.Close()
+}
+
+type redisReservation struct {
+	dedup *RedisDeduplicator
+	key   string
+	token string
+}
+
+func (r *redisReservation) Confirm(ctx context.Context) error {
+	ttl := r.dedup.config.TTL.Milliseconds()
+	if err := confirmScript.Run(ctx, r.dedup.client, []string{r.key}, r.token, keyHandled, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to confirm %s: %w", r.key, err)
+	}
+	return nil
+}
+
+func (r *redisReservation) Release(ctx context.Context) error {
+	if err := releaseScript.Run(ctx, r.dedup.client, []string{r.key}, r.token).Err(); err != nil {
+		return fmt.Errorf("failed to release %s: %w", r.key, err)
+	}
+	return nil
+}
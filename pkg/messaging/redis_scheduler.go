@@ -0,0 +1,348 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/caching"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+	"github.com/metacode-dream-team/MetaCode/pkg/pii"
+	"github.com/redis/go-redis/v9"
+)
+
+// claimScript atomically moves due entries into the in-flight set and records the lease
+// token of this claim, so only one replica publishes them. Payloads live under
+// <data prefix><key>, which the script derives itself, so the scheduler needs a single
+// Redis node rather than a cluster. Entries whose payload expired are dropped. Returns a
+// flat list of key, payload pairs.
+var claimScript = redis.NewScript(`
+local keys = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+local out = {}
+for _, k in ipairs(keys) do
+	redis.call('ZREM', KEYS[1], k)
+	local p = redis.call('GET', ARGV[5] .. k)
+	if p then
+		redis.call('ZADD', KEYS[2], ARGV[2], k)
+		redis.call('HSET', KEYS[3], k, ARGV[4])
+		table.insert(out, k)
+		table.insert(out, p)
+	end
+end
+return out
+`)
+
+// ackScript removes a published entry if the lease token still matches, i.e. the lease
+// did not expire and the entry was not claimed again meanwhile. The payload is only
+// deleted when it was not rescheduled while in flight. Returns 0 when the lease was lost.
+var ackScript = redis.NewScript(`
+if redis.call('HGET', KEYS[3], ARGV[1]) ~= ARGV[3] then
+	return 0
+end
+redis.call('ZREM', KEYS[1], ARGV[1])
+redis.call('HDEL', KEYS[3], ARGV[1])
+if redis.call('GET', KEYS[2]) == ARGV[2] then
+	redis.call('DEL', KEYS[2])
+end
+return 1
+`)
+
+// retryScript puts an entry that failed to publish back into the due set, under the same
+// lease check as ackScript.
+var retryScript = redis.NewScript(`
+if redis.call('HGET', KEYS[4], ARGV[1]) ~= ARGV[4] then
+	return 0
+end
+redis.call('ZREM', KEYS[1], ARGV[1])
+redis.call('HDEL', KEYS[4], ARGV[1])
+if redis.call('GET', KEYS[2]) == ARGV[2] then
+	redis.call('ZADD', KEYS[3], ARGV[3], ARGV[1])
+end
+return 1
+`)
+
+// recoverScript returns entries whose in-flight lease expired (e.g. the replica crashed
+// mid-publish) to the due set and revokes the lease.
+var recoverScript = redis.NewScript(`
+local keys = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+for _, k in ipairs(keys) do
+	redis.call('ZREM', KEYS[1], k)
+	redis.call('HDEL', KEYS[3], k)
+	redis.call('ZADD', KEYS[2], 'NX', ARGV[1], k)
+end
+return #keys
+`)
+
+type SchedulerConfig struct {
+	Redis caching.RedisConfig
+	// KeyPrefix namespaces the Redis keys, e.g. "leetcode-verification"
+	KeyPrefix     string
+	EnableLogging bool
+	LogOutput     io.Writer
+	ErrOutput     io.Writer
+
+	// PollInterval defines how often due events are checked. Default is 1s
+	PollInterval time.Duration
+	// BatchSize limits how many due events are claimed per poll. Default is 100
+	BatchSize int
+	// Lease defines how long a claimed event stays hidden from other replicas
+	// before it is considered lost and retried. Default is 30s
+	Lease time.Duration
+	// RetryBackoff defines the delay before a failed publish is attempted again. Default is 5s
+	RetryBackoff time.Duration
+	// Retention defines how long an event is kept after its due time while it cannot be
+	// published. It is dropped afterwards. Default is 24h
+	Retention time.Duration
+	// Cipher encrypts the fields tagged `pii:"true"` while events wait in Redis, so they
+	// are shredded by Forget like published events. Use the Cipher of the producer
+	Cipher *pii.Cipher
+}
+
+type scheduledEvent struct {
+	ID   uuid.UUID       `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// RedisScheduler stores scheduled events in a Redis sorted set scored by due time and
+// publishes them through the wrapped Producer once they are due.
+//
+// Delivery is at-least-once. A claim holds a lease token, and only the holder of a live
+// lease may acknowledge or retry the event, so replicas never ack each other's claims.
+// An event is still published twice when its lease expires before the publish returns,
+// or when the process dies between Produce and the acknowledgement; Produce of an
+// asynchronous producer only queues the message. Every published event therefore carries
+// an idempotency key (see IdempotencyKeyOf) derived from its schedule key, unique per
+// Schedule call. Consumers with a Deduplicator (ConsumerConfig.Deduplicator) handle each
+// scheduled event once.
+type RedisScheduler struct {
+	config    SchedulerConfig
+	client    *redis.Client
+	producer  Producer
+	dueKey    string
+	flightKey string
+	dataKey   string
+	leaseKey  string
+	logger    *log.Logger
+	errLogger *log.Logger
+}
+
+// Ensure RedisScheduler implements Scheduler
+var _ Scheduler = (*RedisScheduler)(nil)
+
+// NewRedisScheduler creates a new RedisScheduler publishing through the given producer
+func NewRedisScheduler(cfg SchedulerConfig, producer Producer) (*RedisScheduler, error) {
+	if producer == nil {
+		return nil, errors.New("missing producer")
+	}
+	if cfg.KeyPrefix == "" {
+		cfg.KeyPrefix = "scheduler"
+	}
+	if cfg.LogOutput == nil {
+		cfg.LogOutput = os.Stdout
+	}
+	if cfg.ErrOutput == nil {
+		cfg.ErrOutput = os.Stderr
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = 30 * time.Second
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = 5 * time.Second
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = 24 * time.Hour
+	}
+
+	client, err := caching.NewRedisClient(cfg.Redis)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisScheduler{
+		config:    cfg,
+		client:    client,
+		producer:  producer,
+		dueKey:    cfg.KeyPrefix + ":due",
+		flightKey: cfg.KeyPrefix + ":inflight",
+		dataKey:   cfg.KeyPrefix + ":data:",
+		leaseKey:  cfg.KeyPrefix + ":lease",
+		logger:    log.New(cfg.LogOutput, "[SCHEDULER] INFO: ", log.LstdFlags),
+		errLogger: log.New(cfg.ErrOutput, "[SCHEDULER] ERROR: ", log.LstdFlags),
+	}, nil
+}
+
+// Schedule stores the event to be published at the given time, replacing any event
+// already scheduled under the same key
+func (s *RedisScheduler) Schedule(ctx context.Context, key string, at time.Time, eventType string, data interface{}) error {
+	if key == "" {
+		return errors.New("missing schedule key")
+	}
+
+	if err := events.Validate(data); err != nil {
+		return fmt.Errorf("event %s rejected: %w", eventType, err)
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal event failed: %w", err)
+	}
+	if s.config.Cipher != nil {
+		if raw, err = s.config.Cipher.Encrypt(ctx, eventType, raw); err != nil {
+			return fmt.Errorf("encrypt event %s failed: %w", eventType, err)
+		}
+	}
+
+	payload, err := json.Marshal(scheduledEvent{ID: uuid.New(), Type: eventType, Data: raw})
+	if err != nil {
+		return fmt.Errorf("marshal scheduled event failed: %w", err)
+	}
+
+	expireAt := at
+	if now := time.Now(); expireAt.Before(now) {
+		expireAt = now
+	}
+	expireAt = expireAt.Add(s.config.Retention)
+
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetArgs(ctx, s.dataKey+key, payload, redis.SetArgs{ExpireAt: expireAt})
+		pipe.ZAdd(ctx, s.dueKey, redis.Z{Score: float64(at.UnixMilli()), Member: key})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("schedule event failed: %w", err)
+	}
+
+	return nil
+}
+
+// Cancel removes a pending event. It reports false if nothing was scheduled under the key
+func (s *RedisScheduler) Cancel(ctx context.Context, key string) (bool, error) {
+	var removed *redis.IntCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, s.dueKey, key)
+		pipe.ZRem(ctx, s.flightKey, key)
+		pipe.HDel(ctx, s.leaseKey, key)
+		removed = pipe.Del(ctx, s.dataKey+key)
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("cancel scheduled event failed: %w", err)
+	}
+
+	return removed.Val() > 0, nil
+}
+
+// Start polls for due events until the context is cancelled
+func (s *RedisScheduler) Start(ctx context.Context) {
+	s.logInfo("Scheduler started. Polling %s every %v", s.dueKey, s.config.PollInterval)
+
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logInfo("Context cancelled, stopping scheduler...")
+			return
+		case <-ticker.C:
+			s.poll(ctx)
+		}
+	}
+}
+
+func (s *RedisScheduler) poll(ctx context.Context) {
+	now := time.Now()
+
+	if err := recoverScript.Run(ctx, s.client, []string{s.flightKey, s.dueKey, s.leaseKey}, now.UnixMilli()).Err(); err != nil {
+		s.logErr("Failed to recover expired leases: %v", err)
+	}
+
+	token := uuid.NewString()
+	res, err := claimScript.Run(ctx, s.client,
+		[]string{s.dueKey, s.flightKey, s.leaseKey},
+		now.UnixMilli(), now.Add(s.config.Lease).UnixMilli(), s.config.BatchSize, token, s.dataKey,
+	).StringSlice()
+	if err != nil {
+		s.logErr("Failed to claim due events: %v", err)
+		return
+	}
+
+	for i := 0; i+1 < len(res); i += 2 {
+		s.publish(ctx, res[i], res[i+1], token)
+	}
+}
+
+func (s *RedisScheduler) publish(ctx context.Context, key, payload, token string) {
+	var event scheduledEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		s.logErr("Dropping malformed scheduled event %s: %v", key, err)
+		s.ack(ctx, key, payload, token)
+		return
+	}
+
+	// The event ID changes with every Schedule call, so a rescheduled event is not
+	// mistaken for a duplicate of the previous one
+	produceCtx := WithIdempotencyKey(ctx, key+"/"+event.ID.String())
+	err := s.producer.Produce(produceCtx, event.Type, event.Data)
+	if errors.Is(err, pii.ErrKeyShredded) {
+		s.logInfo("Dropping scheduled event %s (%s): %v", key, event.Type, err)
+		s.ack(ctx, key, payload, token)
+		return
+	}
+	if err != nil {
+		retryAt := time.Now().Add(s.config.RetryBackoff).UnixMilli()
+		s.logErr("Failed to publish scheduled event %s (%s): %v. Retrying in %v", key, event.Type, err, s.config.RetryBackoff)
+		res, err := retryScript.Run(ctx, s.client, []string{s.flightKey, s.dataKey + key, s.dueKey, s.leaseKey}, key, payload, retryAt, token).Int()
+		if err != nil {
+			s.logErr("Failed to reschedule event %s: %v", key, err)
+		} else if res == 0 {
+			s.logErr("Lease on scheduled event %s expired, leaving the retry to its new owner", key)
+		}
+		return
+	}
+
+	s.ack(ctx, key, payload, token)
+	s.logInfo("Published scheduled event %s (%s)", key, event.Type)
+}
+
+func (s *RedisScheduler) ack(ctx context.Context, key, payload, token string) {
+	res, err := ackScript.Run(ctx, s.client, []string{s.flightKey, s.dataKey + key, s.leaseKey}, key, payload, token).Int()
+	if err != nil {
+		s.logErr("Failed to acknowledge scheduled event %s: %v", key, err)
+		return
+	}
+	if res == 0 {
+		s.logErr("Lease on scheduled event %s expired before it was acknowledged, it may be published again", key)
+	}
+}
+
+func (s *RedisScheduler) logInfo(format string, v ...interface{}) {
+	if s.config.EnableLogging {
+		s.logger.Printf(format, v...)
+	}
+}
+
+func (s *RedisScheduler) logErr(format string, v ...interface{}) {
+	if s.config.EnableLogging {
+		s.errLogger.Printf(format, v...)
+	}
+}
+
+// Close gracefully closes Redis connection
+func (s *RedisScheduler) Close() {
+	s.logInfo("Closing scheduler...")
+	_ = s.client.Close()
+}
@@ -0,0 +1,15 @@
+package messaging
+
+import (
+	"context"
+	"time"
+)
+
+// Scheduler publishes events at a later point in time unless they are cancelled first.
+// Scheduling an event under a key that is already pending replaces it.
+type Scheduler interface {
+	Schedule(ctx context.Context, key string, at time.Time, eventType string, data interface{}) error
+	Cancel(ctx context.Context, key string) (bool, error)
+	Start(ctx context.Context)
+	Close()
+}
@@ -0,0 +1,435 @@
+package messaging
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrSpoolFull   = errors.New("spool size limit reached")
+	ErrSpoolClosed = errors.New("spool is closed")
+)
+
+const (
+	segmentPrefix = "segment-"
+	segmentSuffix = ".log"
+	cursorFile    = "cursor"
+	// recordHeaderSize is the length and CRC32 prefix of every record
+	recordHeaderSize = 8
+)
+
+type SpoolConfig struct {
+	// Dir holds the segment files. It is created if missing
+	Dir string
+	// MaxSegmentBytes rotates to a new segment file once exceeded. Default is 16MB
+	MaxSegmentBytes int64
+	// MaxTotalBytes rejects new messages with ErrSpoolFull once exceeded. Default is 256MB
+	MaxTotalBytes int64
+	// ReplayInterval defines how often the producer checks the broker to replay the spool. Default is 5s
+	ReplayInterval time.Duration
+}
+
+// SpoolStats describes how much data is waiting to be replayed
+type SpoolStats struct {
+	Messages int64
+	Bytes    int64
+	Segments int
+}
+
+// SpoolHeader mirrors a Kafka message header
+type SpoolHeader struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// SpoolRecord is a message that could not be delivered yet
+type SpoolRecord struct {
+	Key     []byte        `json:"key,omitempty"`
+	Value   []byte        `json:"value"`
+	Headers []SpoolHeader `json:"headers,omitempty"`
+}
+
+type segment struct {
+	id       uint64
+	path     string
+	bytes    int64
+	messages int64
+}
+
+// FileSpool is an append-only, segmented on-disk queue. Records are replayed in the
+// order they were appended; fully replayed segments are deleted.
+type FileSpool struct {
+	mu       sync.Mutex
+	config   SpoolConfig
+	segments []*segment
+	active   *os.File
+	// cursor is the byte offset already replayed in the oldest segment
+	cursor int64
+	closed bool
+}
+
+// OpenFileSpool opens the spool directory, recovering segments left by a previous run
+func OpenFileSpool(cfg SpoolConfig) (*FileSpool, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("missing spool directory")
+	}
+	if cfg.MaxSegmentBytes <= 0 {
+		cfg.MaxSegmentBytes = 16 << 20
+	}
+	if cfg.MaxTotalBytes <= 0 {
+		cfg.MaxTotalBytes = 256 << 20
+	}
+	if cfg.ReplayInterval <= 0 {
+		cfg.ReplayInterval = 5 * time.Second
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create spool directory: %w", err)
+	}
+
+	s := &FileSpool{config: cfg}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSpool) load() error {
+	entries, err := os.ReadDir(s.config.Dir)
+	if err != nil {
+		return fmt.Errorf("failed to read spool directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, segmentPrefix) || !strings.HasSuffix(name, segmentSuffix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, segmentPrefix), segmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		s.segments = append(s.segments, &segment{id: id, path: filepath.Join(s.config.Dir, name)})
+	}
+	sort.Slice(s.segments, func(i, j int) bool { return s.segments[i].id < s.segments[j].id })
+
+	s.cursor = s.readCursor()
+
+	for i, seg := range s.segments {
+		start := int64(0)
+		if i == 0 {
+			start = s.cursor
+		}
+		messages, valid, err := countRecords(seg.path, start)
+		if err != nil {
+			return err
+		}
+		// Drop a torn record left by a crash mid-append. Only the last segment is appended
+		// to, so only its tail can be torn
+		if i == len(s.segments)-1 {
+			if err := os.Truncate(seg.path, valid); err != nil {
+				return fmt.Errorf("failed to truncate spool segment: %w", err)
+			}
+		}
+		seg.messages = messages
+		seg.bytes = valid - start
+	}
+
+	return nil
+}
+
+// Append stores a record at the end of the spool
+func (s *FileSpool) Append(rec SpoolRecord) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("marshal spool record failed: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrSpoolClosed
+	}
+
+	size := int64(len(data) + recordHeaderSize)
+	if s.totalBytes()+size > s.config.MaxTotalBytes {
+		return ErrSpoolFull
+	}
+
+	if err := s.ensureActive(size); err != nil {
+		return err
+	}
+
+	buf := make([]byte, recordHeaderSize, size)
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(data)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(data))
+	buf = append(buf, data...)
+
+	if _, err := s.active.Write(buf); err != nil {
+		return fmt.Errorf("write spool record failed: %w", err)
+	}
+	if err := s.active.Sync(); err != nil {
+		return fmt.Errorf("sync spool segment failed: %w", err)
+	}
+
+	last := s.segments[len(s.segments)-1]
+	last.bytes += size
+	last.messages++
+	return nil
+}
+
+// ensureActive opens the newest segment for appending, rotating when it is full
+func (s *FileSpool) ensureActive(size int64) error {
+	if len(s.segments) > 0 {
+		last := s.segments[len(s.segments)-1]
+		if s.active != nil && last.bytes+size <= s.config.MaxSegmentBytes {
+			return nil
+		}
+		if s.active == nil && last.bytes+size <= s.config.MaxSegmentBytes {
+			f, err := os.OpenFile(last.path, os.O_WRONLY|os.O_APPEND, 0o644)
+			if err != nil {
+				return fmt.Errorf("open spool segment failed: %w", err)
+			}
+			s.active = f
+			return nil
+		}
+	}
+
+	if s.active != nil {
+		_ = s.active.Close()
+		s.active = nil
+	}
+
+	var id uint64 = 1
+	if len(s.segments) > 0 {
+		id = s.segments[len(s.segments)-1].id + 1
+	}
+
+	path := filepath.Join(s.config.Dir, fmt.Sprintf("%s%020d%s", segmentPrefix, id, segmentSuffix))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("create spool segment failed: %w", err)
+	}
+
+	s.active = f
+	s.segments = append(s.segments, &segment{id: id, path: path})
+	return nil
+}
+
+// Replay hands records to fn oldest first. It stops at the first error, and that record
+// is handed out again on the next call.
+func (s *FileSpool) Replay(fn func(SpoolRecord) error) error {
+	for {
+		rec, next, ok, err := s.peek()
+		if err != nil || !ok {
+			return err
+		}
+
+		if err := fn(rec); err != nil {
+			return err
+		}
+
+		if err := s.advance(next); err != nil {
+			return err
+		}
+	}
+}
+
+// peek reads the oldest unreplayed record and the cursor position after it
+func (s *FileSpool) peek() (SpoolRecord, int64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rec SpoolRecord
+	if s.closed {
+		return rec, 0, false, ErrSpoolClosed
+	}
+	// A segment without intact records (e.g. emptied by a torn first record) would block
+	// every segment behind it
+	for len(s.segments) > 1 && s.segments[0].messages == 0 {
+		if err := s.dropHead(); err != nil {
+			return rec, 0, false, err
+		}
+	}
+	if len(s.segments) == 0 || s.segments[0].messages == 0 {
+		return rec, 0, false, nil
+	}
+
+	f, err := os.Open(s.segments[0].path)
+	if err != nil {
+		return rec, 0, false, fmt.Errorf("open spool segment failed: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(s.cursor, io.SeekStart); err != nil {
+		return rec, 0, false, fmt.Errorf("seek spool segment failed: %w", err)
+	}
+
+	data, err := readRecord(bufio.NewReader(f))
+	if err != nil {
+		return rec, 0, false, fmt.Errorf("read spool record failed: %w", err)
+	}
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return rec, 0, false, fmt.Errorf("decode spool record failed: %w", err)
+	}
+
+	return rec, s.cursor + int64(len(data)+recordHeaderSize), true, nil
+}
+
+// advance marks the oldest record as replayed, deleting the segment once it is drained
+func (s *FileSpool) advance(next int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seg := s.segments[0]
+	seg.bytes -= next - s.cursor
+	seg.messages--
+	s.cursor = next
+
+	if seg.messages > 0 {
+		return s.writeCursor()
+	}
+	return s.dropHead()
+}
+
+// dropHead deletes the oldest segment and moves the cursor to the next one. Callers hold mu
+func (s *FileSpool) dropHead() error {
+	if len(s.segments) == 1 && s.active != nil {
+		_ = s.active.Close()
+		s.active = nil
+	}
+	if err := os.Remove(s.segments[0].path); err != nil {
+		return fmt.Errorf("remove spool segment failed: %w", err)
+	}
+
+	s.segments = s.segments[1:]
+	s.cursor = 0
+	return s.writeCursor()
+}
+
+// Stats reports the current spool depth
+func (s *FileSpool) Stats() SpoolStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := SpoolStats{Segments: len(s.segments)}
+	for _, seg := range s.segments {
+		stats.Messages += seg.messages
+		stats.Bytes += seg.bytes
+	}
+	return stats
+}
+
+func (s *FileSpool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	if s.active != nil {
+		err := s.active.Close()
+		s.active = nil
+		return err
+	}
+	return nil
+}
+
+func (s *FileSpool) totalBytes() int64 {
+	var total int64
+	for _, seg := range s.segments {
+		total += seg.bytes
+	}
+	return total
+}
+
+func (s *FileSpool) readCursor() int64 {
+	data, err := os.ReadFile(filepath.Join(s.config.Dir, cursorFile))
+	if err != nil || len(s.segments) == 0 {
+		return 0
+	}
+
+	parts := strings.Fields(string(data))
+	if len(parts) != 2 || parts[0] != strconv.FormatUint(s.segments[0].id, 10) {
+		return 0
+	}
+
+	offset, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return offset
+}
+
+// writeCursor persists the replay position so a restart does not resend delivered records
+func (s *FileSpool) writeCursor() error {
+	path := filepath.Join(s.config.Dir, cursorFile)
+	if len(s.segments) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove spool cursor failed: %w", err)
+		}
+		return nil
+	}
+
+	tmp := path + ".tmp"
+	content := fmt.Sprintf("%d %d\n", s.segments[0].id, s.cursor)
+	if err := os.WriteFile(tmp, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("write spool cursor failed: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write spool cursor failed: %w", err)
+	}
+	return nil
+}
+
+// countRecords counts the intact records from start and returns the offset where they end
+func countRecords(path string, start int64) (int64, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("open spool segment failed: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(start, io.SeekStart); err != nil {
+		return 0, 0, fmt.Errorf("seek spool segment failed: %w", err)
+	}
+
+	r := bufio.NewReader(f)
+	offset := start
+	var messages int64
+	for {
+		data, err := readRecord(r)
+		if err != nil {
+			return messages, offset, nil
+		}
+		messages++
+		offset += int64(len(data) + recordHeaderSize)
+	}
+}
+
+func readRecord(r io.Reader) ([]byte, error) {
+	header := make([]byte, recordHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, binary.BigEndian.Uint32(header[0:4]))
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, errors.New("spool record checksum mismatch")
+	}
+	return data, nil
+}
@@ -0,0 +1,361 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/achievement.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AchievementGrantedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AchievementId string                 `protobuf:"bytes,2,opt,name=achievement_id,json=achievementId,proto3" json:"achievement_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IconUrl       *string                `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3,oneof" json:"icon_url,omitempty"`
+	Tier          string                 `protobuf:"bytes,6,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AchievementGrantedEvent) Reset() {
+	*x = AchievementGrantedEvent{}
+	mi := &file_events_achievement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementGrantedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementGrantedEvent) ProtoMessage() {}
+
+func (x *AchievementGrantedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_achievement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementGrantedEvent.ProtoReflect.Descriptor instead.
+func (*AchievementGrantedEvent) Descriptor() ([]byte, []int) {
+	return file_events_achievement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AchievementGrantedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AchievementGrantedEvent) GetAchievementId() string {
+	if x != nil {
+		return x.AchievementId
+	}
+	return ""
+}
+
+func (x *AchievementGrantedEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AchievementGrantedEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AchievementGrantedEvent) GetIconUrl() string {
+	if x != nil && x.IconUrl != nil {
+		return *x.IconUrl
+	}
+	return ""
+}
+
+func (x *AchievementGrantedEvent) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+type AchievementProgressed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AchievementId string                 `protobuf:"bytes,2,opt,name=achievement_id,json=achievementId,proto3" json:"achievement_id,omitempty"`
+	Tier          string                 `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	Current       int32                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`
+	Target        int32                  `protobuf:"varint,5,opt,name=target,proto3" json:"target,omitempty"`
+	ProgressedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=progressed_at,json=progressedAt,proto3" json:"progressed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AchievementProgressed) Reset() {
+	*x = AchievementProgressed{}
+	mi := &file_events_achievement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementProgressed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementProgressed) ProtoMessage() {}
+
+func (x *AchievementProgressed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_achievement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementProgressed.ProtoReflect.Descriptor instead.
+func (*AchievementProgressed) Descriptor() ([]byte, []int) {
+	return file_events_achievement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AchievementProgressed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AchievementProgressed) GetAchievementId() string {
+	if x != nil {
+		return x.AchievementId
+	}
+	return ""
+}
+
+func (x *AchievementProgressed) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *AchievementProgressed) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *AchievementProgressed) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *AchievementProgressed) GetProgressedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProgressedAt
+	}
+	return nil
+}
+
+type AchievementRevoked struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AchievementId string                 `protobuf:"bytes,2,opt,name=achievement_id,json=achievementId,proto3" json:"achievement_id,omitempty"`
+	Tier          string                 `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RevokedBy     *string                `protobuf:"bytes,5,opt,name=revoked_by,json=revokedBy,proto3,oneof" json:"revoked_by,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AchievementRevoked) Reset() {
+	*x = AchievementRevoked{}
+	mi := &file_events_achievement_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementRevoked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementRevoked) ProtoMessage() {}
+
+func (x *AchievementRevoked) ProtoReflect() protoreflect.Message {
+	mi := &file_events_achievement_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementRevoked.ProtoReflect.Descriptor instead.
+func (*AchievementRevoked) Descriptor() ([]byte, []int) {
+	return file_events_achievement_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AchievementRevoked) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AchievementRevoked) GetAchievementId() string {
+	if x != nil {
+		return x.AchievementId
+	}
+	return ""
+}
+
+func (x *AchievementRevoked) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *AchievementRevoked) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AchievementRevoked) GetRevokedBy() string {
+	if x != nil && x.RevokedBy != nil {
+		return *x.RevokedBy
+	}
+	return ""
+}
+
+func (x *AchievementRevoked) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+var File_events_achievement_proto protoreflect.FileDescriptor
+
+const file_events_achievement_proto_rawDesc = "" +
+	"\n" +
+	"\x18events/achievement.proto\x12\x12metacode.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd0\x01\n" +
+	"\x17AchievementGrantedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eachievement_id\x18\x02 \x01(\tR\rachievementId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1e\n" +
+	"\bicon_url\x18\x05 \x01(\tH\x00R\aiconUrl\x88\x01\x01\x12\x12\n" +
+	"\x04tier\x18\x06 \x01(\tR\x04tierB\v\n" +
+	"\t_icon_url\"\xde\x01\n" +
+	"\x15AchievementProgressed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eachievement_id\x18\x02 \x01(\tR\rachievementId\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\tR\x04tier\x12\x18\n" +
+	"\acurrent\x18\x04 \x01(\x05R\acurrent\x12\x16\n" +
+	"\x06target\x18\x05 \x01(\x05R\x06target\x12?\n" +
+	"\rprogressed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fprogressedAt\"\xee\x01\n" +
+	"\x12AchievementRevoked\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0eachievement_id\x18\x02 \x01(\tR\rachievementId\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\tR\x04tier\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\"\n" +
+	"\n" +
+	"revoked_by\x18\x05 \x01(\tH\x00R\trevokedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAtB\r\n" +
+	"\v_revoked_byB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_achievement_proto_rawDescOnce sync.Once
+	file_events_achievement_proto_rawDescData []byte
+)
+
+func file_events_achievement_proto_rawDescGZIP() []byte {
+	file_events_achievement_proto_rawDescOnce.Do(func() {
+		file_events_achievement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_achievement_proto_rawDesc), len(file_events_achievement_proto_rawDesc)))
+	})
+	return file_events_achievement_proto_rawDescData
+}
+
+var file_events_achievement_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_events_achievement_proto_goTypes = []any{
+	(*AchievementGrantedEvent)(nil), // 0: metacode.events.v1.AchievementGrantedEvent
+	(*AchievementProgressed)(nil),   // 1: metacode.events.v1.AchievementProgressed
+	(*AchievementRevoked)(nil),      // 2: metacode.events.v1.AchievementRevoked
+	(*timestamppb.Timestamp)(nil),   // 3: google.protobuf.Timestamp
+}
+var file_events_achievement_proto_depIdxs = []int32{
+	3, // 0: metacode.events.v1.AchievementProgressed.progressed_at:type_name -> google.protobuf.Timestamp
+	3, // 1: metacode.events.v1.AchievementRevoked.revoked_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_events_achievement_proto_init() }
+func file_events_achievement_proto_init() {
+	if File_events_achievement_proto != nil {
+		return
+	}
+	file_events_achievement_proto_msgTypes[0].OneofWrappers = []any{}
+	file_events_achievement_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_achievement_proto_rawDesc), len(file_events_achievement_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_achievement_proto_goTypes,
+		DependencyIndexes: file_events_achievement_proto_depIdxs,
+		MessageInfos:      file_events_achievement_proto_msgTypes,
+	}.Build()
+	File_events_achievement_proto = out.File
+	file_events_achievement_proto_goTypes = nil
+	file_events_achievement_proto_depIdxs = nil
+}
@@ -0,0 +1,888 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/codeforces.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CodeforcesAccountBound struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodeforcesHandle string                 `protobuf:"bytes,2,opt,name=codeforces_handle,json=codeforcesHandle,proto3" json:"codeforces_handle,omitempty"`
+	BoundAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=bound_at,json=boundAt,proto3" json:"bound_at,omitempty"`
+	Verified         bool                   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodeforcesAccountBound) Reset() {
+	*x = CodeforcesAccountBound{}
+	mi := &file_events_codeforces_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeforcesAccountBound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeforcesAccountBound) ProtoMessage() {}
+
+func (x *CodeforcesAccountBound) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codeforces_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeforcesAccountBound.ProtoReflect.Descriptor instead.
+func (*CodeforcesAccountBound) Descriptor() ([]byte, []int) {
+	return file_events_codeforces_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CodeforcesAccountBound) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodeforcesAccountBound) GetCodeforcesHandle() string {
+	if x != nil {
+		return x.CodeforcesHandle
+	}
+	return ""
+}
+
+func (x *CodeforcesAccountBound) GetBoundAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BoundAt
+	}
+	return nil
+}
+
+func (x *CodeforcesAccountBound) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type CodeforcesAccountUnbound struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodeforcesHandle string                 `protobuf:"bytes,2,opt,name=codeforces_handle,json=codeforcesHandle,proto3" json:"codeforces_handle,omitempty"`
+	UnboundAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=unbound_at,json=unboundAt,proto3" json:"unbound_at,omitempty"`
+	Reason           string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodeforcesAccountUnbound) Reset() {
+	*x = CodeforcesAccountUnbound{}
+	mi := &file_events_codeforces_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeforcesAccountUnbound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeforcesAccountUnbound) ProtoMessage() {}
+
+func (x *CodeforcesAccountUnbound) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codeforces_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeforcesAccountUnbound.ProtoReflect.Descriptor instead.
+func (*CodeforcesAccountUnbound) Descriptor() ([]byte, []int) {
+	return file_events_codeforces_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CodeforcesAccountUnbound) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodeforcesAccountUnbound) GetCodeforcesHandle() string {
+	if x != nil {
+		return x.CodeforcesHandle
+	}
+	return ""
+}
+
+func (x *CodeforcesAccountUnbound) GetUnboundAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnboundAt
+	}
+	return nil
+}
+
+func (x *CodeforcesAccountUnbound) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CodeforcesVerificationSucceeded struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodeforcesHandle string                 `protobuf:"bytes,2,opt,name=codeforces_handle,json=codeforcesHandle,proto3" json:"codeforces_handle,omitempty"`
+	VerifiedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodeforcesVerificationSucceeded) Reset() {
+	*x = CodeforcesVerificationSucceeded{}
+	mi := &file_events_codeforces_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeforcesVerificationSucceeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeforcesVerificationSucceeded) ProtoMessage() {}
+
+func (x *CodeforcesVerificationSucceeded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codeforces_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeforcesVerificationSucceeded.ProtoReflect.Descriptor instead.
+func (*CodeforcesVerificationSucceeded) Descriptor() ([]byte, []int) {
+	return file_events_codeforces_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CodeforcesVerificationSucceeded) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodeforcesVerificationSucceeded) GetCodeforcesHandle() string {
+	if x != nil {
+		return x.CodeforcesHandle
+	}
+	return ""
+}
+
+func (x *CodeforcesVerificationSucceeded) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+type CodeforcesVerificationFailed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodeforcesHandle string                 `protobuf:"bytes,2,opt,name=codeforces_handle,json=codeforcesHandle,proto3" json:"codeforces_handle,omitempty"`
+	FailedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	Reason           string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ErrorCode        string                 `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodeforcesVerificationFailed) Reset() {
+	*x = CodeforcesVerificationFailed{}
+	mi := &file_events_codeforces_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeforcesVerificationFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeforcesVerificationFailed) ProtoMessage() {}
+
+func (x *CodeforcesVerificationFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codeforces_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeforcesVerificationFailed.ProtoReflect.Descriptor instead.
+func (*CodeforcesVerificationFailed) Descriptor() ([]byte, []int) {
+	return file_events_codeforces_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CodeforcesVerificationFailed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodeforcesVerificationFailed) GetCodeforcesHandle() string {
+	if x != nil {
+		return x.CodeforcesHandle
+	}
+	return ""
+}
+
+func (x *CodeforcesVerificationFailed) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+func (x *CodeforcesVerificationFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CodeforcesVerificationFailed) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+type CodeforcesProfileUpdated struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodeforcesHandle     string                 `protobuf:"bytes,2,opt,name=codeforces_handle,json=codeforcesHandle,proto3" json:"codeforces_handle,omitempty"`
+	Rating               int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	MaxRating            int32                  `protobuf:"varint,4,opt,name=max_rating,json=maxRating,proto3" json:"max_rating,omitempty"`
+	Rank                 string                 `protobuf:"bytes,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	MaxRank              string                 `protobuf:"bytes,6,opt,name=max_rank,json=maxRank,proto3" json:"max_rank,omitempty"`
+	ProblemsSolved       int32                  `protobuf:"varint,7,opt,name=problems_solved,json=problemsSolved,proto3" json:"problems_solved,omitempty"`
+	ContestsParticipated int32                  `protobuf:"varint,8,opt,name=contests_participated,json=contestsParticipated,proto3" json:"contests_participated,omitempty"`
+	Verified             bool                   `protobuf:"varint,9,opt,name=verified,proto3" json:"verified,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ChangeReason         string                 `protobuf:"bytes,11,opt,name=change_reason,json=changeReason,proto3" json:"change_reason,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CodeforcesProfileUpdated) Reset() {
+	*x = CodeforcesProfileUpdated{}
+	mi := &file_events_codeforces_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeforcesProfileUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeforcesProfileUpdated) ProtoMessage() {}
+
+func (x *CodeforcesProfileUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codeforces_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeforcesProfileUpdated.ProtoReflect.Descriptor instead.
+func (*CodeforcesProfileUpdated) Descriptor() ([]byte, []int) {
+	return file_events_codeforces_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CodeforcesProfileUpdated) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodeforcesProfileUpdated) GetCodeforcesHandle() string {
+	if x != nil {
+		return x.CodeforcesHandle
+	}
+	return ""
+}
+
+func (x *CodeforcesProfileUpdated) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CodeforcesProfileUpdated) GetMaxRating() int32 {
+	if x != nil {
+		return x.MaxRating
+	}
+	return 0
+}
+
+func (x *CodeforcesProfileUpdated) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *CodeforcesProfileUpdated) GetMaxRank() string {
+	if x != nil {
+		return x.MaxRank
+	}
+	return ""
+}
+
+func (x *CodeforcesProfileUpdated) GetProblemsSolved() int32 {
+	if x != nil {
+		return x.ProblemsSolved
+	}
+	return 0
+}
+
+func (x *CodeforcesProfileUpdated) GetContestsParticipated() int32 {
+	if x != nil {
+		return x.ContestsParticipated
+	}
+	return 0
+}
+
+func (x *CodeforcesProfileUpdated) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *CodeforcesProfileUpdated) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CodeforcesProfileUpdated) GetChangeReason() string {
+	if x != nil {
+		return x.ChangeReason
+	}
+	return ""
+}
+
+type CodeforcesHistoryImportCompleted struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodeforcesHandle string                 `protobuf:"bytes,2,opt,name=codeforces_handle,json=codeforcesHandle,proto3" json:"codeforces_handle,omitempty"`
+	YearsImported    []int32                `protobuf:"varint,3,rep,packed,name=years_imported,json=yearsImported,proto3" json:"years_imported,omitempty"`
+	TotalYears       int32                  `protobuf:"varint,4,opt,name=total_years,json=totalYears,proto3" json:"total_years,omitempty"`
+	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodeforcesHistoryImportCompleted) Reset() {
+	*x = CodeforcesHistoryImportCompleted{}
+	mi := &file_events_codeforces_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeforcesHistoryImportCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeforcesHistoryImportCompleted) ProtoMessage() {}
+
+func (x *CodeforcesHistoryImportCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codeforces_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeforcesHistoryImportCompleted.ProtoReflect.Descriptor instead.
+func (*CodeforcesHistoryImportCompleted) Descriptor() ([]byte, []int) {
+	return file_events_codeforces_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CodeforcesHistoryImportCompleted) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodeforcesHistoryImportCompleted) GetCodeforcesHandle() string {
+	if x != nil {
+		return x.CodeforcesHandle
+	}
+	return ""
+}
+
+func (x *CodeforcesHistoryImportCompleted) GetYearsImported() []int32 {
+	if x != nil {
+		return x.YearsImported
+	}
+	return nil
+}
+
+func (x *CodeforcesHistoryImportCompleted) GetTotalYears() int32 {
+	if x != nil {
+		return x.TotalYears
+	}
+	return 0
+}
+
+func (x *CodeforcesHistoryImportCompleted) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type CodeforcesHistoryImportFailed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodeforcesHandle string                 `protobuf:"bytes,2,opt,name=codeforces_handle,json=codeforcesHandle,proto3" json:"codeforces_handle,omitempty"`
+	YearsAttempted   []int32                `protobuf:"varint,3,rep,packed,name=years_attempted,json=yearsAttempted,proto3" json:"years_attempted,omitempty"`
+	Error            string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode        string                 `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	FailedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodeforcesHistoryImportFailed) Reset() {
+	*x = CodeforcesHistoryImportFailed{}
+	mi := &file_events_codeforces_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeforcesHistoryImportFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeforcesHistoryImportFailed) ProtoMessage() {}
+
+func (x *CodeforcesHistoryImportFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codeforces_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeforcesHistoryImportFailed.ProtoReflect.Descriptor instead.
+func (*CodeforcesHistoryImportFailed) Descriptor() ([]byte, []int) {
+	return file_events_codeforces_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CodeforcesHistoryImportFailed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodeforcesHistoryImportFailed) GetCodeforcesHandle() string {
+	if x != nil {
+		return x.CodeforcesHandle
+	}
+	return ""
+}
+
+func (x *CodeforcesHistoryImportFailed) GetYearsAttempted() []int32 {
+	if x != nil {
+		return x.YearsAttempted
+	}
+	return nil
+}
+
+func (x *CodeforcesHistoryImportFailed) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CodeforcesHistoryImportFailed) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *CodeforcesHistoryImportFailed) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+type CodeforcesCurrentYearRefreshed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodeforcesHandle string                 `protobuf:"bytes,2,opt,name=codeforces_handle,json=codeforcesHandle,proto3" json:"codeforces_handle,omitempty"`
+	Year             int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	ProblemsSolved   int32                  `protobuf:"varint,4,opt,name=problems_solved,json=problemsSolved,proto3" json:"problems_solved,omitempty"`
+	ActiveDays       int32                  `protobuf:"varint,5,opt,name=active_days,json=activeDays,proto3" json:"active_days,omitempty"`
+	RefreshedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodeforcesCurrentYearRefreshed) Reset() {
+	*x = CodeforcesCurrentYearRefreshed{}
+	mi := &file_events_codeforces_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeforcesCurrentYearRefreshed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeforcesCurrentYearRefreshed) ProtoMessage() {}
+
+func (x *CodeforcesCurrentYearRefreshed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codeforces_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeforcesCurrentYearRefreshed.ProtoReflect.Descriptor instead.
+func (*CodeforcesCurrentYearRefreshed) Descriptor() ([]byte, []int) {
+	return file_events_codeforces_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CodeforcesCurrentYearRefreshed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodeforcesCurrentYearRefreshed) GetCodeforcesHandle() string {
+	if x != nil {
+		return x.CodeforcesHandle
+	}
+	return ""
+}
+
+func (x *CodeforcesCurrentYearRefreshed) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CodeforcesCurrentYearRefreshed) GetProblemsSolved() int32 {
+	if x != nil {
+		return x.ProblemsSolved
+	}
+	return 0
+}
+
+func (x *CodeforcesCurrentYearRefreshed) GetActiveDays() int32 {
+	if x != nil {
+		return x.ActiveDays
+	}
+	return 0
+}
+
+func (x *CodeforcesCurrentYearRefreshed) GetRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return nil
+}
+
+type CodeforcesTodayContributed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodeforcesHandle string                 `protobuf:"bytes,2,opt,name=codeforces_handle,json=codeforcesHandle,proto3" json:"codeforces_handle,omitempty"`
+	Count            int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Date             string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Timezone         string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodeforcesTodayContributed) Reset() {
+	*x = CodeforcesTodayContributed{}
+	mi := &file_events_codeforces_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodeforcesTodayContributed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeforcesTodayContributed) ProtoMessage() {}
+
+func (x *CodeforcesTodayContributed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codeforces_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeforcesTodayContributed.ProtoReflect.Descriptor instead.
+func (*CodeforcesTodayContributed) Descriptor() ([]byte, []int) {
+	return file_events_codeforces_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CodeforcesTodayContributed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodeforcesTodayContributed) GetCodeforcesHandle() string {
+	if x != nil {
+		return x.CodeforcesHandle
+	}
+	return ""
+}
+
+func (x *CodeforcesTodayContributed) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CodeforcesTodayContributed) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CodeforcesTodayContributed) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CodeforcesTodayContributed) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_events_codeforces_proto protoreflect.FileDescriptor
+
+const file_events_codeforces_proto_rawDesc = "" +
+	"\n" +
+	"\x17events/codeforces.proto\x12\x12metacode.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb1\x01\n" +
+	"\x16CodeforcesAccountBound\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codeforces_handle\x18\x02 \x01(\tR\x10codeforcesHandle\x125\n" +
+	"\bbound_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aboundAt\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\bR\bverified\"\xb3\x01\n" +
+	"\x18CodeforcesAccountUnbound\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codeforces_handle\x18\x02 \x01(\tR\x10codeforcesHandle\x129\n" +
+	"\n" +
+	"unbound_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tunboundAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xa4\x01\n" +
+	"\x1fCodeforcesVerificationSucceeded\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codeforces_handle\x18\x02 \x01(\tR\x10codeforcesHandle\x12;\n" +
+	"\vverified_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\"\xd4\x01\n" +
+	"\x1cCodeforcesVerificationFailed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codeforces_handle\x18\x02 \x01(\tR\x10codeforcesHandle\x127\n" +
+	"\tfailed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\tR\terrorCode\"\xa0\x03\n" +
+	"\x18CodeforcesProfileUpdated\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codeforces_handle\x18\x02 \x01(\tR\x10codeforcesHandle\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x1d\n" +
+	"\n" +
+	"max_rating\x18\x04 \x01(\x05R\tmaxRating\x12\x12\n" +
+	"\x04rank\x18\x05 \x01(\tR\x04rank\x12\x19\n" +
+	"\bmax_rank\x18\x06 \x01(\tR\amaxRank\x12'\n" +
+	"\x0fproblems_solved\x18\a \x01(\x05R\x0eproblemsSolved\x123\n" +
+	"\x15contests_participated\x18\b \x01(\x05R\x14contestsParticipated\x12\x1a\n" +
+	"\bverified\x18\t \x01(\bR\bverified\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rchange_reason\x18\v \x01(\tR\fchangeReason\"\xef\x01\n" +
+	" CodeforcesHistoryImportCompleted\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codeforces_handle\x18\x02 \x01(\tR\x10codeforcesHandle\x12%\n" +
+	"\x0eyears_imported\x18\x03 \x03(\x05R\ryearsImported\x12\x1f\n" +
+	"\vtotal_years\x18\x04 \x01(\x05R\n" +
+	"totalYears\x12=\n" +
+	"\fcompleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xfc\x01\n" +
+	"\x1dCodeforcesHistoryImportFailed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codeforces_handle\x18\x02 \x01(\tR\x10codeforcesHandle\x12'\n" +
+	"\x0fyears_attempted\x18\x03 \x03(\x05R\x0eyearsAttempted\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\tR\terrorCode\x127\n" +
+	"\tfailed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\"\x83\x02\n" +
+	"\x1eCodeforcesCurrentYearRefreshed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codeforces_handle\x18\x02 \x01(\tR\x10codeforcesHandle\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12'\n" +
+	"\x0fproblems_solved\x18\x04 \x01(\x05R\x0eproblemsSolved\x12\x1f\n" +
+	"\vactive_days\x18\x05 \x01(\x05R\n" +
+	"activeDays\x12=\n" +
+	"\frefreshed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vrefreshedAt\"\xe3\x01\n" +
+	"\x1aCodeforcesTodayContributed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codeforces_handle\x18\x02 \x01(\tR\x10codeforcesHandle\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_codeforces_proto_rawDescOnce sync.Once
+	file_events_codeforces_proto_rawDescData []byte
+)
+
+func file_events_codeforces_proto_rawDescGZIP() []byte {
+	file_events_codeforces_proto_rawDescOnce.Do(func() {
+		file_events_codeforces_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_codeforces_proto_rawDesc), len(file_events_codeforces_proto_rawDesc)))
+	})
+	return file_events_codeforces_proto_rawDescData
+}
+
+var file_events_codeforces_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_events_codeforces_proto_goTypes = []any{
+	(*CodeforcesAccountBound)(nil),           // 0: metacode.events.v1.CodeforcesAccountBound
+	(*CodeforcesAccountUnbound)(nil),         // 1: metacode.events.v1.CodeforcesAccountUnbound
+	(*CodeforcesVerificationSucceeded)(nil),  // 2: metacode.events.v1.CodeforcesVerificationSucceeded
+	(*CodeforcesVerificationFailed)(nil),     // 3: metacode.events.v1.CodeforcesVerificationFailed
+	(*CodeforcesProfileUpdated)(nil),         // 4: metacode.events.v1.CodeforcesProfileUpdated
+	(*CodeforcesHistoryImportCompleted)(nil), // 5: metacode.events.v1.CodeforcesHistoryImportCompleted
+	(*CodeforcesHistoryImportFailed)(nil),    // 6: metacode.events.v1.CodeforcesHistoryImportFailed
+	(*CodeforcesCurrentYearRefreshed)(nil),   // 7: metacode.events.v1.CodeforcesCurrentYearRefreshed
+	(*CodeforcesTodayContributed)(nil),       // 8: metacode.events.v1.CodeforcesTodayContributed
+	(*timestamppb.Timestamp)(nil),            // 9: google.protobuf.Timestamp
+}
+var file_events_codeforces_proto_depIdxs = []int32{
+	9, // 0: metacode.events.v1.CodeforcesAccountBound.bound_at:type_name -> google.protobuf.Timestamp
+	9, // 1: metacode.events.v1.CodeforcesAccountUnbound.unbound_at:type_name -> google.protobuf.Timestamp
+	9, // 2: metacode.events.v1.CodeforcesVerificationSucceeded.verified_at:type_name -> google.protobuf.Timestamp
+	9, // 3: metacode.events.v1.CodeforcesVerificationFailed.failed_at:type_name -> google.protobuf.Timestamp
+	9, // 4: metacode.events.v1.CodeforcesProfileUpdated.updated_at:type_name -> google.protobuf.Timestamp
+	9, // 5: metacode.events.v1.CodeforcesHistoryImportCompleted.completed_at:type_name -> google.protobuf.Timestamp
+	9, // 6: metacode.events.v1.CodeforcesHistoryImportFailed.failed_at:type_name -> google.protobuf.Timestamp
+	9, // 7: metacode.events.v1.CodeforcesCurrentYearRefreshed.refreshed_at:type_name -> google.protobuf.Timestamp
+	9, // 8: metacode.events.v1.CodeforcesTodayContributed.updated_at:type_name -> google.protobuf.Timestamp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_events_codeforces_proto_init() }
+func file_events_codeforces_proto_init() {
+	if File_events_codeforces_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_codeforces_proto_rawDesc), len(file_events_codeforces_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_codeforces_proto_goTypes,
+		DependencyIndexes: file_events_codeforces_proto_depIdxs,
+		MessageInfos:      file_events_codeforces_proto_msgTypes,
+	}.Build()
+	File_events_codeforces_proto = out.File
+	file_events_codeforces_proto_goTypes = nil
+	file_events_codeforces_proto_depIdxs = nil
+}
@@ -0,0 +1,878 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/codewars.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CodewarsAccountBound struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodewarsUsername string                 `protobuf:"bytes,2,opt,name=codewars_username,json=codewarsUsername,proto3" json:"codewars_username,omitempty"`
+	BoundAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=bound_at,json=boundAt,proto3" json:"bound_at,omitempty"`
+	Verified         bool                   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodewarsAccountBound) Reset() {
+	*x = CodewarsAccountBound{}
+	mi := &file_events_codewars_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodewarsAccountBound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodewarsAccountBound) ProtoMessage() {}
+
+func (x *CodewarsAccountBound) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codewars_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodewarsAccountBound.ProtoReflect.Descriptor instead.
+func (*CodewarsAccountBound) Descriptor() ([]byte, []int) {
+	return file_events_codewars_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CodewarsAccountBound) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodewarsAccountBound) GetCodewarsUsername() string {
+	if x != nil {
+		return x.CodewarsUsername
+	}
+	return ""
+}
+
+func (x *CodewarsAccountBound) GetBoundAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BoundAt
+	}
+	return nil
+}
+
+func (x *CodewarsAccountBound) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type CodewarsAccountUnbound struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodewarsUsername string                 `protobuf:"bytes,2,opt,name=codewars_username,json=codewarsUsername,proto3" json:"codewars_username,omitempty"`
+	UnboundAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=unbound_at,json=unboundAt,proto3" json:"unbound_at,omitempty"`
+	Reason           string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodewarsAccountUnbound) Reset() {
+	*x = CodewarsAccountUnbound{}
+	mi := &file_events_codewars_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodewarsAccountUnbound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodewarsAccountUnbound) ProtoMessage() {}
+
+func (x *CodewarsAccountUnbound) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codewars_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodewarsAccountUnbound.ProtoReflect.Descriptor instead.
+func (*CodewarsAccountUnbound) Descriptor() ([]byte, []int) {
+	return file_events_codewars_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CodewarsAccountUnbound) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodewarsAccountUnbound) GetCodewarsUsername() string {
+	if x != nil {
+		return x.CodewarsUsername
+	}
+	return ""
+}
+
+func (x *CodewarsAccountUnbound) GetUnboundAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnboundAt
+	}
+	return nil
+}
+
+func (x *CodewarsAccountUnbound) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CodewarsVerificationSucceeded struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodewarsUsername string                 `protobuf:"bytes,2,opt,name=codewars_username,json=codewarsUsername,proto3" json:"codewars_username,omitempty"`
+	VerifiedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodewarsVerificationSucceeded) Reset() {
+	*x = CodewarsVerificationSucceeded{}
+	mi := &file_events_codewars_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodewarsVerificationSucceeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodewarsVerificationSucceeded) ProtoMessage() {}
+
+func (x *CodewarsVerificationSucceeded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codewars_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodewarsVerificationSucceeded.ProtoReflect.Descriptor instead.
+func (*CodewarsVerificationSucceeded) Descriptor() ([]byte, []int) {
+	return file_events_codewars_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CodewarsVerificationSucceeded) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodewarsVerificationSucceeded) GetCodewarsUsername() string {
+	if x != nil {
+		return x.CodewarsUsername
+	}
+	return ""
+}
+
+func (x *CodewarsVerificationSucceeded) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+type CodewarsVerificationFailed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodewarsUsername string                 `protobuf:"bytes,2,opt,name=codewars_username,json=codewarsUsername,proto3" json:"codewars_username,omitempty"`
+	FailedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	Reason           string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ErrorCode        string                 `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodewarsVerificationFailed) Reset() {
+	*x = CodewarsVerificationFailed{}
+	mi := &file_events_codewars_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodewarsVerificationFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodewarsVerificationFailed) ProtoMessage() {}
+
+func (x *CodewarsVerificationFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codewars_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodewarsVerificationFailed.ProtoReflect.Descriptor instead.
+func (*CodewarsVerificationFailed) Descriptor() ([]byte, []int) {
+	return file_events_codewars_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CodewarsVerificationFailed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodewarsVerificationFailed) GetCodewarsUsername() string {
+	if x != nil {
+		return x.CodewarsUsername
+	}
+	return ""
+}
+
+func (x *CodewarsVerificationFailed) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+func (x *CodewarsVerificationFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CodewarsVerificationFailed) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+type CodewarsProfileUpdated struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodewarsUsername    string                 `protobuf:"bytes,2,opt,name=codewars_username,json=codewarsUsername,proto3" json:"codewars_username,omitempty"`
+	Honor               int32                  `protobuf:"varint,3,opt,name=honor,proto3" json:"honor,omitempty"`
+	Rank                string                 `protobuf:"bytes,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	Score               int32                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	KatasCompleted      int32                  `protobuf:"varint,6,opt,name=katas_completed,json=katasCompleted,proto3" json:"katas_completed,omitempty"`
+	LeaderboardPosition int32                  `protobuf:"varint,7,opt,name=leaderboard_position,json=leaderboardPosition,proto3" json:"leaderboard_position,omitempty"`
+	Verified            bool                   `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ChangeReason        string                 `protobuf:"bytes,10,opt,name=change_reason,json=changeReason,proto3" json:"change_reason,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CodewarsProfileUpdated) Reset() {
+	*x = CodewarsProfileUpdated{}
+	mi := &file_events_codewars_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodewarsProfileUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodewarsProfileUpdated) ProtoMessage() {}
+
+func (x *CodewarsProfileUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codewars_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodewarsProfileUpdated.ProtoReflect.Descriptor instead.
+func (*CodewarsProfileUpdated) Descriptor() ([]byte, []int) {
+	return file_events_codewars_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CodewarsProfileUpdated) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodewarsProfileUpdated) GetCodewarsUsername() string {
+	if x != nil {
+		return x.CodewarsUsername
+	}
+	return ""
+}
+
+func (x *CodewarsProfileUpdated) GetHonor() int32 {
+	if x != nil {
+		return x.Honor
+	}
+	return 0
+}
+
+func (x *CodewarsProfileUpdated) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *CodewarsProfileUpdated) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CodewarsProfileUpdated) GetKatasCompleted() int32 {
+	if x != nil {
+		return x.KatasCompleted
+	}
+	return 0
+}
+
+func (x *CodewarsProfileUpdated) GetLeaderboardPosition() int32 {
+	if x != nil {
+		return x.LeaderboardPosition
+	}
+	return 0
+}
+
+func (x *CodewarsProfileUpdated) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *CodewarsProfileUpdated) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CodewarsProfileUpdated) GetChangeReason() string {
+	if x != nil {
+		return x.ChangeReason
+	}
+	return ""
+}
+
+type CodewarsHistoryImportCompleted struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodewarsUsername string                 `protobuf:"bytes,2,opt,name=codewars_username,json=codewarsUsername,proto3" json:"codewars_username,omitempty"`
+	YearsImported    []int32                `protobuf:"varint,3,rep,packed,name=years_imported,json=yearsImported,proto3" json:"years_imported,omitempty"`
+	TotalYears       int32                  `protobuf:"varint,4,opt,name=total_years,json=totalYears,proto3" json:"total_years,omitempty"`
+	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodewarsHistoryImportCompleted) Reset() {
+	*x = CodewarsHistoryImportCompleted{}
+	mi := &file_events_codewars_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodewarsHistoryImportCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodewarsHistoryImportCompleted) ProtoMessage() {}
+
+func (x *CodewarsHistoryImportCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codewars_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodewarsHistoryImportCompleted.ProtoReflect.Descriptor instead.
+func (*CodewarsHistoryImportCompleted) Descriptor() ([]byte, []int) {
+	return file_events_codewars_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CodewarsHistoryImportCompleted) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodewarsHistoryImportCompleted) GetCodewarsUsername() string {
+	if x != nil {
+		return x.CodewarsUsername
+	}
+	return ""
+}
+
+func (x *CodewarsHistoryImportCompleted) GetYearsImported() []int32 {
+	if x != nil {
+		return x.YearsImported
+	}
+	return nil
+}
+
+func (x *CodewarsHistoryImportCompleted) GetTotalYears() int32 {
+	if x != nil {
+		return x.TotalYears
+	}
+	return 0
+}
+
+func (x *CodewarsHistoryImportCompleted) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type CodewarsHistoryImportFailed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodewarsUsername string                 `protobuf:"bytes,2,opt,name=codewars_username,json=codewarsUsername,proto3" json:"codewars_username,omitempty"`
+	YearsAttempted   []int32                `protobuf:"varint,3,rep,packed,name=years_attempted,json=yearsAttempted,proto3" json:"years_attempted,omitempty"`
+	Error            string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode        string                 `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	FailedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodewarsHistoryImportFailed) Reset() {
+	*x = CodewarsHistoryImportFailed{}
+	mi := &file_events_codewars_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodewarsHistoryImportFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodewarsHistoryImportFailed) ProtoMessage() {}
+
+func (x *CodewarsHistoryImportFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codewars_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodewarsHistoryImportFailed.ProtoReflect.Descriptor instead.
+func (*CodewarsHistoryImportFailed) Descriptor() ([]byte, []int) {
+	return file_events_codewars_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CodewarsHistoryImportFailed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodewarsHistoryImportFailed) GetCodewarsUsername() string {
+	if x != nil {
+		return x.CodewarsUsername
+	}
+	return ""
+}
+
+func (x *CodewarsHistoryImportFailed) GetYearsAttempted() []int32 {
+	if x != nil {
+		return x.YearsAttempted
+	}
+	return nil
+}
+
+func (x *CodewarsHistoryImportFailed) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CodewarsHistoryImportFailed) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *CodewarsHistoryImportFailed) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+type CodewarsCurrentYearRefreshed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodewarsUsername string                 `protobuf:"bytes,2,opt,name=codewars_username,json=codewarsUsername,proto3" json:"codewars_username,omitempty"`
+	Year             int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	KatasCompleted   int32                  `protobuf:"varint,4,opt,name=katas_completed,json=katasCompleted,proto3" json:"katas_completed,omitempty"`
+	ActiveDays       int32                  `protobuf:"varint,5,opt,name=active_days,json=activeDays,proto3" json:"active_days,omitempty"`
+	RefreshedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodewarsCurrentYearRefreshed) Reset() {
+	*x = CodewarsCurrentYearRefreshed{}
+	mi := &file_events_codewars_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodewarsCurrentYearRefreshed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodewarsCurrentYearRefreshed) ProtoMessage() {}
+
+func (x *CodewarsCurrentYearRefreshed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codewars_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodewarsCurrentYearRefreshed.ProtoReflect.Descriptor instead.
+func (*CodewarsCurrentYearRefreshed) Descriptor() ([]byte, []int) {
+	return file_events_codewars_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CodewarsCurrentYearRefreshed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodewarsCurrentYearRefreshed) GetCodewarsUsername() string {
+	if x != nil {
+		return x.CodewarsUsername
+	}
+	return ""
+}
+
+func (x *CodewarsCurrentYearRefreshed) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CodewarsCurrentYearRefreshed) GetKatasCompleted() int32 {
+	if x != nil {
+		return x.KatasCompleted
+	}
+	return 0
+}
+
+func (x *CodewarsCurrentYearRefreshed) GetActiveDays() int32 {
+	if x != nil {
+		return x.ActiveDays
+	}
+	return 0
+}
+
+func (x *CodewarsCurrentYearRefreshed) GetRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return nil
+}
+
+type CodewarsTodayContributed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CodewarsUsername string                 `protobuf:"bytes,2,opt,name=codewars_username,json=codewarsUsername,proto3" json:"codewars_username,omitempty"`
+	Count            int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Date             string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Timezone         string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CodewarsTodayContributed) Reset() {
+	*x = CodewarsTodayContributed{}
+	mi := &file_events_codewars_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CodewarsTodayContributed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodewarsTodayContributed) ProtoMessage() {}
+
+func (x *CodewarsTodayContributed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_codewars_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodewarsTodayContributed.ProtoReflect.Descriptor instead.
+func (*CodewarsTodayContributed) Descriptor() ([]byte, []int) {
+	return file_events_codewars_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CodewarsTodayContributed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CodewarsTodayContributed) GetCodewarsUsername() string {
+	if x != nil {
+		return x.CodewarsUsername
+	}
+	return ""
+}
+
+func (x *CodewarsTodayContributed) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CodewarsTodayContributed) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CodewarsTodayContributed) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CodewarsTodayContributed) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_events_codewars_proto protoreflect.FileDescriptor
+
+const file_events_codewars_proto_rawDesc = "" +
+	"\n" +
+	"\x15events/codewars.proto\x12\x12metacode.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\x01\n" +
+	"\x14CodewarsAccountBound\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codewars_username\x18\x02 \x01(\tR\x10codewarsUsername\x125\n" +
+	"\bbound_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aboundAt\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\bR\bverified\"\xb1\x01\n" +
+	"\x16CodewarsAccountUnbound\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codewars_username\x18\x02 \x01(\tR\x10codewarsUsername\x129\n" +
+	"\n" +
+	"unbound_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tunboundAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xa2\x01\n" +
+	"\x1dCodewarsVerificationSucceeded\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codewars_username\x18\x02 \x01(\tR\x10codewarsUsername\x12;\n" +
+	"\vverified_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\"\xd2\x01\n" +
+	"\x1aCodewarsVerificationFailed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codewars_username\x18\x02 \x01(\tR\x10codewarsUsername\x127\n" +
+	"\tfailed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\tR\terrorCode\"\xf6\x02\n" +
+	"\x16CodewarsProfileUpdated\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codewars_username\x18\x02 \x01(\tR\x10codewarsUsername\x12\x14\n" +
+	"\x05honor\x18\x03 \x01(\x05R\x05honor\x12\x12\n" +
+	"\x04rank\x18\x04 \x01(\tR\x04rank\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x05R\x05score\x12'\n" +
+	"\x0fkatas_completed\x18\x06 \x01(\x05R\x0ekatasCompleted\x121\n" +
+	"\x14leaderboard_position\x18\a \x01(\x05R\x13leaderboardPosition\x12\x1a\n" +
+	"\bverified\x18\b \x01(\bR\bverified\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rchange_reason\x18\n" +
+	" \x01(\tR\fchangeReason\"\xed\x01\n" +
+	"\x1eCodewarsHistoryImportCompleted\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codewars_username\x18\x02 \x01(\tR\x10codewarsUsername\x12%\n" +
+	"\x0eyears_imported\x18\x03 \x03(\x05R\ryearsImported\x12\x1f\n" +
+	"\vtotal_years\x18\x04 \x01(\x05R\n" +
+	"totalYears\x12=\n" +
+	"\fcompleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xfa\x01\n" +
+	"\x1bCodewarsHistoryImportFailed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codewars_username\x18\x02 \x01(\tR\x10codewarsUsername\x12'\n" +
+	"\x0fyears_attempted\x18\x03 \x03(\x05R\x0eyearsAttempted\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\tR\terrorCode\x127\n" +
+	"\tfailed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\"\x81\x02\n" +
+	"\x1cCodewarsCurrentYearRefreshed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codewars_username\x18\x02 \x01(\tR\x10codewarsUsername\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12'\n" +
+	"\x0fkatas_completed\x18\x04 \x01(\x05R\x0ekatasCompleted\x12\x1f\n" +
+	"\vactive_days\x18\x05 \x01(\x05R\n" +
+	"activeDays\x12=\n" +
+	"\frefreshed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vrefreshedAt\"\xe1\x01\n" +
+	"\x18CodewarsTodayContributed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11codewars_username\x18\x02 \x01(\tR\x10codewarsUsername\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_codewars_proto_rawDescOnce sync.Once
+	file_events_codewars_proto_rawDescData []byte
+)
+
+func file_events_codewars_proto_rawDescGZIP() []byte {
+	file_events_codewars_proto_rawDescOnce.Do(func() {
+		file_events_codewars_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_codewars_proto_rawDesc), len(file_events_codewars_proto_rawDesc)))
+	})
+	return file_events_codewars_proto_rawDescData
+}
+
+var file_events_codewars_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_events_codewars_proto_goTypes = []any{
+	(*CodewarsAccountBound)(nil),           // 0: metacode.events.v1.CodewarsAccountBound
+	(*CodewarsAccountUnbound)(nil),         // 1: metacode.events.v1.CodewarsAccountUnbound
+	(*CodewarsVerificationSucceeded)(nil),  // 2: metacode.events.v1.CodewarsVerificationSucceeded
+	(*CodewarsVerificationFailed)(nil),     // 3: metacode.events.v1.CodewarsVerificationFailed
+	(*CodewarsProfileUpdated)(nil),         // 4: metacode.events.v1.CodewarsProfileUpdated
+	(*CodewarsHistoryImportCompleted)(nil), // 5: metacode.events.v1.CodewarsHistoryImportCompleted
+	(*CodewarsHistoryImportFailed)(nil),    // 6: metacode.events.v1.CodewarsHistoryImportFailed
+	(*CodewarsCurrentYearRefreshed)(nil),   // 7: metacode.events.v1.CodewarsCurrentYearRefreshed
+	(*CodewarsTodayContributed)(nil),       // 8: metacode.events.v1.CodewarsTodayContributed
+	(*timestamppb.Timestamp)(nil),          // 9: google.protobuf.Timestamp
+}
+var file_events_codewars_proto_depIdxs = []int32{
+	9, // 0: metacode.events.v1.CodewarsAccountBound.bound_at:type_name -> google.protobuf.Timestamp
+	9, // 1: metacode.events.v1.CodewarsAccountUnbound.unbound_at:type_name -> google.protobuf.Timestamp
+	9, // 2: metacode.events.v1.CodewarsVerificationSucceeded.verified_at:type_name -> google.protobuf.Timestamp
+	9, // 3: metacode.events.v1.CodewarsVerificationFailed.failed_at:type_name -> google.protobuf.Timestamp
+	9, // 4: metacode.events.v1.CodewarsProfileUpdated.updated_at:type_name -> google.protobuf.Timestamp
+	9, // 5: metacode.events.v1.CodewarsHistoryImportCompleted.completed_at:type_name -> google.protobuf.Timestamp
+	9, // 6: metacode.events.v1.CodewarsHistoryImportFailed.failed_at:type_name -> google.protobuf.Timestamp
+	9, // 7: metacode.events.v1.CodewarsCurrentYearRefreshed.refreshed_at:type_name -> google.protobuf.Timestamp
+	9, // 8: metacode.events.v1.CodewarsTodayContributed.updated_at:type_name -> google.protobuf.Timestamp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_events_codewars_proto_init() }
+func file_events_codewars_proto_init() {
+	if File_events_codewars_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_codewars_proto_rawDesc), len(file_events_codewars_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_codewars_proto_goTypes,
+		DependencyIndexes: file_events_codewars_proto_depIdxs,
+		MessageInfos:      file_events_codewars_proto_msgTypes,
+	}.Build()
+	File_events_codewars_proto = out.File
+	file_events_codewars_proto_goTypes = nil
+	file_events_codewars_proto_depIdxs = nil
+}
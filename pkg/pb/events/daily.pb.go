@@ -0,0 +1,158 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/daily.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TodayContributedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Timezone      string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TodayContributedEvent) Reset() {
+	*x = TodayContributedEvent{}
+	mi := &file_events_daily_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TodayContributedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodayContributedEvent) ProtoMessage() {}
+
+func (x *TodayContributedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_daily_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodayContributedEvent.ProtoReflect.Descriptor instead.
+func (*TodayContributedEvent) Descriptor() ([]byte, []int) {
+	return file_events_daily_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TodayContributedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TodayContributedEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TodayContributedEvent) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TodayContributedEvent) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TodayContributedEvent) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+var File_events_daily_proto protoreflect.FileDescriptor
+
+const file_events_daily_proto_rawDesc = "" +
+	"\n" +
+	"\x12events/daily.proto\x12\x12metacode.events.v1\"\x8e\x01\n" +
+	"\x15TodayContributedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezoneB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_daily_proto_rawDescOnce sync.Once
+	file_events_daily_proto_rawDescData []byte
+)
+
+func file_events_daily_proto_rawDescGZIP() []byte {
+	file_events_daily_proto_rawDescOnce.Do(func() {
+		file_events_daily_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_daily_proto_rawDesc), len(file_events_daily_proto_rawDesc)))
+	})
+	return file_events_daily_proto_rawDescData
+}
+
+var file_events_daily_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_events_daily_proto_goTypes = []any{
+	(*TodayContributedEvent)(nil), // 0: metacode.events.v1.TodayContributedEvent
+}
+var file_events_daily_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_events_daily_proto_init() }
+func file_events_daily_proto_init() {
+	if File_events_daily_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_daily_proto_rawDesc), len(file_events_daily_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_daily_proto_goTypes,
+		DependencyIndexes: file_events_daily_proto_depIdxs,
+		MessageInfos:      file_events_daily_proto_msgTypes,
+	}.Build()
+	File_events_daily_proto = out.File
+	file_events_daily_proto_goTypes = nil
+	file_events_daily_proto_depIdxs = nil
+}
@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/file_events.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AvatarUpdatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	S3OriginalUrl string                 `protobuf:"bytes,2,opt,name=s3_original_url,json=s3OriginalUrl,proto3" json:"s3_original_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvatarUpdatedEvent) Reset() {
+	*x = AvatarUpdatedEvent{}
+	mi := &file_events_file_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvatarUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvatarUpdatedEvent) ProtoMessage() {}
+
+func (x *AvatarUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_file_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvatarUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*AvatarUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_events_file_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AvatarUpdatedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AvatarUpdatedEvent) GetS3OriginalUrl() string {
+	if x != nil {
+		return x.S3OriginalUrl
+	}
+	return ""
+}
+
+type AvatarProcessingFinishedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	S3SmallUrl    string                 `protobuf:"bytes,2,opt,name=s3_small_url,json=s3SmallUrl,proto3" json:"s3_small_url,omitempty"`
+	S3MediumUrl   string                 `protobuf:"bytes,3,opt,name=s3_medium_url,json=s3MediumUrl,proto3" json:"s3_medium_url,omitempty"`
+	S3LargeUrl    string                 `protobuf:"bytes,4,opt,name=s3_large_url,json=s3LargeUrl,proto3" json:"s3_large_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvatarProcessingFinishedEvent) Reset() {
+	*x = AvatarProcessingFinishedEvent{}
+	mi := &file_events_file_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvatarProcessingFinishedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvatarProcessingFinishedEvent) ProtoMessage() {}
+
+func (x *AvatarProcessingFinishedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_file_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvatarProcessingFinishedEvent.ProtoReflect.Descriptor instead.
+func (*AvatarProcessingFinishedEvent) Descriptor() ([]byte, []int) {
+	return file_events_file_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AvatarProcessingFinishedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AvatarProcessingFinishedEvent) GetS3SmallUrl() string {
+	if x != nil {
+		return x.S3SmallUrl
+	}
+	return ""
+}
+
+func (x *AvatarProcessingFinishedEvent) GetS3MediumUrl() string {
+	if x != nil {
+		return x.S3MediumUrl
+	}
+	return ""
+}
+
+func (x *AvatarProcessingFinishedEvent) GetS3LargeUrl() string {
+	if x != nil {
+		return x.S3LargeUrl
+	}
+	return ""
+}
+
+var File_events_file_events_proto protoreflect.FileDescriptor
+
+const file_events_file_events_proto_rawDesc = "" +
+	"\n" +
+	"\x18events/file_events.proto\x12\x12metacode.events.v1\"U\n" +
+	"\x12AvatarUpdatedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0fs3_original_url\x18\x02 \x01(\tR\rs3OriginalUrl\"\xa0\x01\n" +
+	"\x1dAvatarProcessingFinishedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\fs3_small_url\x18\x02 \x01(\tR\n" +
+	"s3SmallUrl\x12\"\n" +
+	"\rs3_medium_url\x18\x03 \x01(\tR\vs3MediumUrl\x12 \n" +
+	"\fs3_large_url\x18\x04 \x01(\tR\n" +
+	"s3LargeUrlB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_file_events_proto_rawDescOnce sync.Once
+	file_events_file_events_proto_rawDescData []byte
+)
+
+func file_events_file_events_proto_rawDescGZIP() []byte {
+	file_events_file_events_proto_rawDescOnce.Do(func() {
+		file_events_file_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_file_events_proto_rawDesc), len(file_events_file_events_proto_rawDesc)))
+	})
+	return file_events_file_events_proto_rawDescData
+}
+
+var file_events_file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_file_events_proto_goTypes = []any{
+	(*AvatarUpdatedEvent)(nil),            // 0: metacode.events.v1.AvatarUpdatedEvent
+	(*AvatarProcessingFinishedEvent)(nil), // 1: metacode.events.v1.AvatarProcessingFinishedEvent
+}
+var file_events_file_events_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_events_file_events_proto_init() }
+func file_events_file_events_proto_init() {
+	if File_events_file_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_file_events_proto_rawDesc), len(file_events_file_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_file_events_proto_goTypes,
+		DependencyIndexes: file_events_file_events_proto_depIdxs,
+		MessageInfos:      file_events_file_events_proto_msgTypes,
+	}.Build()
+	File_events_file_events_proto = out.File
+	file_events_file_events_proto_goTypes = nil
+	file_events_file_events_proto_depIdxs = nil
+}
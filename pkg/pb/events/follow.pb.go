@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/follow.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UserFollowed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    string                 `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FolloweeId    string                 `protobuf:"bytes,2,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	Mutual        bool                   `protobuf:"varint,3,opt,name=mutual,proto3" json:"mutual,omitempty"`
+	FollowedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=followed_at,json=followedAt,proto3" json:"followed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFollowed) Reset() {
+	*x = UserFollowed{}
+	mi := &file_events_follow_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFollowed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFollowed) ProtoMessage() {}
+
+func (x *UserFollowed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_follow_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFollowed.ProtoReflect.Descriptor instead.
+func (*UserFollowed) Descriptor() ([]byte, []int) {
+	return file_events_follow_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserFollowed) GetFollowerId() string {
+	if x != nil {
+		return x.FollowerId
+	}
+	return ""
+}
+
+func (x *UserFollowed) GetFolloweeId() string {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return ""
+}
+
+func (x *UserFollowed) GetMutual() bool {
+	if x != nil {
+		return x.Mutual
+	}
+	return false
+}
+
+func (x *UserFollowed) GetFollowedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FollowedAt
+	}
+	return nil
+}
+
+type UserUnfollowed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    string                 `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FolloweeId    string                 `protobuf:"bytes,2,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	UnfollowedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=unfollowed_at,json=unfollowedAt,proto3" json:"unfollowed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUnfollowed) Reset() {
+	*x = UserUnfollowed{}
+	mi := &file_events_follow_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUnfollowed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUnfollowed) ProtoMessage() {}
+
+func (x *UserUnfollowed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_follow_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUnfollowed.ProtoReflect.Descriptor instead.
+func (*UserUnfollowed) Descriptor() ([]byte, []int) {
+	return file_events_follow_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserUnfollowed) GetFollowerId() string {
+	if x != nil {
+		return x.FollowerId
+	}
+	return ""
+}
+
+func (x *UserUnfollowed) GetFolloweeId() string {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return ""
+}
+
+func (x *UserUnfollowed) GetUnfollowedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnfollowedAt
+	}
+	return nil
+}
+
+var File_events_follow_proto protoreflect.FileDescriptor
+
+const file_events_follow_proto_rawDesc = "" +
+	"\n" +
+	"\x13events/follow.proto\x12\x12metacode.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x01\n" +
+	"\fUserFollowed\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\tR\n" +
+	"followerId\x12\x1f\n" +
+	"\vfollowee_id\x18\x02 \x01(\tR\n" +
+	"followeeId\x12\x16\n" +
+	"\x06mutual\x18\x03 \x01(\bR\x06mutual\x12;\n" +
+	"\vfollowed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"followedAt\"\x93\x01\n" +
+	"\x0eUserUnfollowed\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\tR\n" +
+	"followerId\x12\x1f\n" +
+	"\vfollowee_id\x18\x02 \x01(\tR\n" +
+	"followeeId\x12?\n" +
+	"\runfollowed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\funfollowedAtB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_follow_proto_rawDescOnce sync.Once
+	file_events_follow_proto_rawDescData []byte
+)
+
+func file_events_follow_proto_rawDescGZIP() []byte {
+	file_events_follow_proto_rawDescOnce.Do(func() {
+		file_events_follow_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_follow_proto_rawDesc), len(file_events_follow_proto_rawDesc)))
+	})
+	return file_events_follow_proto_rawDescData
+}
+
+var file_events_follow_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_follow_proto_goTypes = []any{
+	(*UserFollowed)(nil),          // 0: metacode.events.v1.UserFollowed
+	(*UserUnfollowed)(nil),        // 1: metacode.events.v1.UserUnfollowed
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_events_follow_proto_depIdxs = []int32{
+	2, // 0: metacode.events.v1.UserFollowed.followed_at:type_name -> google.protobuf.Timestamp
+	2, // 1: metacode.events.v1.UserUnfollowed.unfollowed_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_events_follow_proto_init() }
+func file_events_follow_proto_init() {
+	if File_events_follow_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_follow_proto_rawDesc), len(file_events_follow_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_follow_proto_goTypes,
+		DependencyIndexes: file_events_follow_proto_depIdxs,
+		MessageInfos:      file_events_follow_proto_msgTypes,
+	}.Build()
+	File_events_follow_proto = out.File
+	file_events_follow_proto_goTypes = nil
+	file_events_follow_proto_depIdxs = nil
+}
@@ -0,0 +1,585 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/github.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GitHubAccountLinked struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GithubUserId   string                 `protobuf:"bytes,2,opt,name=github_user_id,json=githubUserId,proto3" json:"github_user_id,omitempty"`
+	GithubUsername string                 `protobuf:"bytes,3,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
+	LinkedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GitHubAccountLinked) Reset() {
+	*x = GitHubAccountLinked{}
+	mi := &file_events_github_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubAccountLinked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubAccountLinked) ProtoMessage() {}
+
+func (x *GitHubAccountLinked) ProtoReflect() protoreflect.Message {
+	mi := &file_events_github_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubAccountLinked.ProtoReflect.Descriptor instead.
+func (*GitHubAccountLinked) Descriptor() ([]byte, []int) {
+	return file_events_github_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GitHubAccountLinked) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GitHubAccountLinked) GetGithubUserId() string {
+	if x != nil {
+		return x.GithubUserId
+	}
+	return ""
+}
+
+func (x *GitHubAccountLinked) GetGithubUsername() string {
+	if x != nil {
+		return x.GithubUsername
+	}
+	return ""
+}
+
+func (x *GitHubAccountLinked) GetLinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return nil
+}
+
+type GitHubAccountUnlinked struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GithubUsername string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
+	UnlinkedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=unlinked_at,json=unlinkedAt,proto3" json:"unlinked_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GitHubAccountUnlinked) Reset() {
+	*x = GitHubAccountUnlinked{}
+	mi := &file_events_github_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubAccountUnlinked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubAccountUnlinked) ProtoMessage() {}
+
+func (x *GitHubAccountUnlinked) ProtoReflect() protoreflect.Message {
+	mi := &file_events_github_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubAccountUnlinked.ProtoReflect.Descriptor instead.
+func (*GitHubAccountUnlinked) Descriptor() ([]byte, []int) {
+	return file_events_github_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GitHubAccountUnlinked) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GitHubAccountUnlinked) GetGithubUsername() string {
+	if x != nil {
+		return x.GithubUsername
+	}
+	return ""
+}
+
+func (x *GitHubAccountUnlinked) GetUnlinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlinkedAt
+	}
+	return nil
+}
+
+type GitHubProfileUpdated struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GithubUsername     string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
+	TotalContributions int32                  `protobuf:"varint,3,opt,name=total_contributions,json=totalContributions,proto3" json:"total_contributions,omitempty"`
+	CurrentYear        int32                  `protobuf:"varint,4,opt,name=current_year,json=currentYear,proto3" json:"current_year,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Reason             string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GitHubProfileUpdated) Reset() {
+	*x = GitHubProfileUpdated{}
+	mi := &file_events_github_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubProfileUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubProfileUpdated) ProtoMessage() {}
+
+func (x *GitHubProfileUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_github_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubProfileUpdated.ProtoReflect.Descriptor instead.
+func (*GitHubProfileUpdated) Descriptor() ([]byte, []int) {
+	return file_events_github_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GitHubProfileUpdated) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GitHubProfileUpdated) GetGithubUsername() string {
+	if x != nil {
+		return x.GithubUsername
+	}
+	return ""
+}
+
+func (x *GitHubProfileUpdated) GetTotalContributions() int32 {
+	if x != nil {
+		return x.TotalContributions
+	}
+	return 0
+}
+
+func (x *GitHubProfileUpdated) GetCurrentYear() int32 {
+	if x != nil {
+		return x.CurrentYear
+	}
+	return 0
+}
+
+func (x *GitHubProfileUpdated) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GitHubProfileUpdated) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GitHubHistoryImportCompleted struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GithubUsername string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
+	YearsImported  []int32                `protobuf:"varint,3,rep,packed,name=years_imported,json=yearsImported,proto3" json:"years_imported,omitempty"`
+	TotalYears     int32                  `protobuf:"varint,4,opt,name=total_years,json=totalYears,proto3" json:"total_years,omitempty"`
+	CompletedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GitHubHistoryImportCompleted) Reset() {
+	*x = GitHubHistoryImportCompleted{}
+	mi := &file_events_github_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubHistoryImportCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubHistoryImportCompleted) ProtoMessage() {}
+
+func (x *GitHubHistoryImportCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_github_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubHistoryImportCompleted.ProtoReflect.Descriptor instead.
+func (*GitHubHistoryImportCompleted) Descriptor() ([]byte, []int) {
+	return file_events_github_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GitHubHistoryImportCompleted) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GitHubHistoryImportCompleted) GetGithubUsername() string {
+	if x != nil {
+		return x.GithubUsername
+	}
+	return ""
+}
+
+func (x *GitHubHistoryImportCompleted) GetYearsImported() []int32 {
+	if x != nil {
+		return x.YearsImported
+	}
+	return nil
+}
+
+func (x *GitHubHistoryImportCompleted) GetTotalYears() int32 {
+	if x != nil {
+		return x.TotalYears
+	}
+	return 0
+}
+
+func (x *GitHubHistoryImportCompleted) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type GitHubHistoryImportFailed struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GithubUsername string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
+	YearsAttempted []int32                `protobuf:"varint,3,rep,packed,name=years_attempted,json=yearsAttempted,proto3" json:"years_attempted,omitempty"`
+	Error          string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode      string                 `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	FailedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GitHubHistoryImportFailed) Reset() {
+	*x = GitHubHistoryImportFailed{}
+	mi := &file_events_github_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubHistoryImportFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubHistoryImportFailed) ProtoMessage() {}
+
+func (x *GitHubHistoryImportFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_github_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubHistoryImportFailed.ProtoReflect.Descriptor instead.
+func (*GitHubHistoryImportFailed) Descriptor() ([]byte, []int) {
+	return file_events_github_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GitHubHistoryImportFailed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GitHubHistoryImportFailed) GetGithubUsername() string {
+	if x != nil {
+		return x.GithubUsername
+	}
+	return ""
+}
+
+func (x *GitHubHistoryImportFailed) GetYearsAttempted() []int32 {
+	if x != nil {
+		return x.YearsAttempted
+	}
+	return nil
+}
+
+func (x *GitHubHistoryImportFailed) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GitHubHistoryImportFailed) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GitHubHistoryImportFailed) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+type GitHubCurrentYearRefreshed struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GithubUsername     string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
+	Year               int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	TotalContributions int32                  `protobuf:"varint,4,opt,name=total_contributions,json=totalContributions,proto3" json:"total_contributions,omitempty"`
+	DaysWithActivity   int32                  `protobuf:"varint,5,opt,name=days_with_activity,json=daysWithActivity,proto3" json:"days_with_activity,omitempty"`
+	RefreshedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GitHubCurrentYearRefreshed) Reset() {
+	*x = GitHubCurrentYearRefreshed{}
+	mi := &file_events_github_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubCurrentYearRefreshed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubCurrentYearRefreshed) ProtoMessage() {}
+
+func (x *GitHubCurrentYearRefreshed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_github_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubCurrentYearRefreshed.ProtoReflect.Descriptor instead.
+func (*GitHubCurrentYearRefreshed) Descriptor() ([]byte, []int) {
+	return file_events_github_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GitHubCurrentYearRefreshed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GitHubCurrentYearRefreshed) GetGithubUsername() string {
+	if x != nil {
+		return x.GithubUsername
+	}
+	return ""
+}
+
+func (x *GitHubCurrentYearRefreshed) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GitHubCurrentYearRefreshed) GetTotalContributions() int32 {
+	if x != nil {
+		return x.TotalContributions
+	}
+	return 0
+}
+
+func (x *GitHubCurrentYearRefreshed) GetDaysWithActivity() int32 {
+	if x != nil {
+		return x.DaysWithActivity
+	}
+	return 0
+}
+
+func (x *GitHubCurrentYearRefreshed) GetRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return nil
+}
+
+var File_events_github_proto protoreflect.FileDescriptor
+
+const file_events_github_proto_rawDesc = "" +
+	"\n" +
+	"\x13events/github.proto\x12\x12metacode.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x01\n" +
+	"\x13GitHubAccountLinked\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x0egithub_user_id\x18\x02 \x01(\tR\fgithubUserId\x12'\n" +
+	"\x0fgithub_username\x18\x03 \x01(\tR\x0egithubUsername\x127\n" +
+	"\tlinked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\blinkedAt\"\x96\x01\n" +
+	"\x15GitHubAccountUnlinked\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fgithub_username\x18\x02 \x01(\tR\x0egithubUsername\x12;\n" +
+	"\vunlinked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"unlinkedAt\"\xff\x01\n" +
+	"\x14GitHubProfileUpdated\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fgithub_username\x18\x02 \x01(\tR\x0egithubUsername\x12/\n" +
+	"\x13total_contributions\x18\x03 \x01(\x05R\x12totalContributions\x12!\n" +
+	"\fcurrent_year\x18\x04 \x01(\x05R\vcurrentYear\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\xe7\x01\n" +
+	"\x1cGitHubHistoryImportCompleted\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fgithub_username\x18\x02 \x01(\tR\x0egithubUsername\x12%\n" +
+	"\x0eyears_imported\x18\x03 \x03(\x05R\ryearsImported\x12\x1f\n" +
+	"\vtotal_years\x18\x04 \x01(\x05R\n" +
+	"totalYears\x12=\n" +
+	"\fcompleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xf4\x01\n" +
+	"\x19GitHubHistoryImportFailed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fgithub_username\x18\x02 \x01(\tR\x0egithubUsername\x12'\n" +
+	"\x0fyears_attempted\x18\x03 \x03(\x05R\x0eyearsAttempted\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\tR\terrorCode\x127\n" +
+	"\tfailed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\"\x90\x02\n" +
+	"\x1aGitHubCurrentYearRefreshed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fgithub_username\x18\x02 \x01(\tR\x0egithubUsername\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12/\n" +
+	"\x13total_contributions\x18\x04 \x01(\x05R\x12totalContributions\x12,\n" +
+	"\x12days_with_activity\x18\x05 \x01(\x05R\x10daysWithActivity\x12=\n" +
+	"\frefreshed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vrefreshedAtB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_github_proto_rawDescOnce sync.Once
+	file_events_github_proto_rawDescData []byte
+)
+
+func file_events_github_proto_rawDescGZIP() []byte {
+	file_events_github_proto_rawDescOnce.Do(func() {
+		file_events_github_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_github_proto_rawDesc), len(file_events_github_proto_rawDesc)))
+	})
+	return file_events_github_proto_rawDescData
+}
+
+var file_events_github_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_events_github_proto_goTypes = []any{
+	(*GitHubAccountLinked)(nil),          // 0: metacode.events.v1.GitHubAccountLinked
+	(*GitHubAccountUnlinked)(nil),        // 1: metacode.events.v1.GitHubAccountUnlinked
+	(*GitHubProfileUpdated)(nil),         // 2: metacode.events.v1.GitHubProfileUpdated
+	(*GitHubHistoryImportCompleted)(nil), // 3: metacode.events.v1.GitHubHistoryImportCompleted
+	(*GitHubHistoryImportFailed)(nil),    // 4: metacode.events.v1.GitHubHistoryImportFailed
+	(*GitHubCurrentYearRefreshed)(nil),   // 5: metacode.events.v1.GitHubCurrentYearRefreshed
+	(*timestamppb.Timestamp)(nil),        // 6: google.protobuf.Timestamp
+}
+var file_events_github_proto_depIdxs = []int32{
+	6, // 0: metacode.events.v1.GitHubAccountLinked.linked_at:type_name -> google.protobuf.Timestamp
+	6, // 1: metacode.events.v1.GitHubAccountUnlinked.unlinked_at:type_name -> google.protobuf.Timestamp
+	6, // 2: metacode.events.v1.GitHubProfileUpdated.updated_at:type_name -> google.protobuf.Timestamp
+	6, // 3: metacode.events.v1.GitHubHistoryImportCompleted.completed_at:type_name -> google.protobuf.Timestamp
+	6, // 4: metacode.events.v1.GitHubHistoryImportFailed.failed_at:type_name -> google.protobuf.Timestamp
+	6, // 5: metacode.events.v1.GitHubCurrentYearRefreshed.refreshed_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_events_github_proto_init() }
+func file_events_github_proto_init() {
+	if File_events_github_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_github_proto_rawDesc), len(file_events_github_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_github_proto_goTypes,
+		DependencyIndexes: file_events_github_proto_depIdxs,
+		MessageInfos:      file_events_github_proto_msgTypes,
+	}.Build()
+	File_events_github_proto = out.File
+	file_events_github_proto_goTypes = nil
+	file_events_github_proto_depIdxs = nil
+}
@@ -0,0 +1,456 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/integration.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IntegrationAccountLinked struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Verified      bool                   `protobuf:"varint,5,opt,name=verified,proto3" json:"verified,omitempty"`
+	LinkedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationAccountLinked) Reset() {
+	*x = IntegrationAccountLinked{}
+	mi := &file_events_integration_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationAccountLinked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationAccountLinked) ProtoMessage() {}
+
+func (x *IntegrationAccountLinked) ProtoReflect() protoreflect.Message {
+	mi := &file_events_integration_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationAccountLinked.ProtoReflect.Descriptor instead.
+func (*IntegrationAccountLinked) Descriptor() ([]byte, []int) {
+	return file_events_integration_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IntegrationAccountLinked) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IntegrationAccountLinked) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *IntegrationAccountLinked) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *IntegrationAccountLinked) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *IntegrationAccountLinked) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *IntegrationAccountLinked) GetLinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return nil
+}
+
+type IntegrationAccountUnlinked struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	UnlinkedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=unlinked_at,json=unlinkedAt,proto3" json:"unlinked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationAccountUnlinked) Reset() {
+	*x = IntegrationAccountUnlinked{}
+	mi := &file_events_integration_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationAccountUnlinked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationAccountUnlinked) ProtoMessage() {}
+
+func (x *IntegrationAccountUnlinked) ProtoReflect() protoreflect.Message {
+	mi := &file_events_integration_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationAccountUnlinked.ProtoReflect.Descriptor instead.
+func (*IntegrationAccountUnlinked) Descriptor() ([]byte, []int) {
+	return file_events_integration_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IntegrationAccountUnlinked) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IntegrationAccountUnlinked) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *IntegrationAccountUnlinked) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *IntegrationAccountUnlinked) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *IntegrationAccountUnlinked) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *IntegrationAccountUnlinked) GetUnlinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlinkedAt
+	}
+	return nil
+}
+
+type IntegrationVerificationSucceeded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	VerifiedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationVerificationSucceeded) Reset() {
+	*x = IntegrationVerificationSucceeded{}
+	mi := &file_events_integration_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationVerificationSucceeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationVerificationSucceeded) ProtoMessage() {}
+
+func (x *IntegrationVerificationSucceeded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_integration_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationVerificationSucceeded.ProtoReflect.Descriptor instead.
+func (*IntegrationVerificationSucceeded) Descriptor() ([]byte, []int) {
+	return file_events_integration_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IntegrationVerificationSucceeded) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IntegrationVerificationSucceeded) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *IntegrationVerificationSucceeded) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *IntegrationVerificationSucceeded) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *IntegrationVerificationSucceeded) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+type IntegrationVerificationFailed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ErrorCode     string                 `protobuf:"bytes,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	FailedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationVerificationFailed) Reset() {
+	*x = IntegrationVerificationFailed{}
+	mi := &file_events_integration_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationVerificationFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationVerificationFailed) ProtoMessage() {}
+
+func (x *IntegrationVerificationFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_integration_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationVerificationFailed.ProtoReflect.Descriptor instead.
+func (*IntegrationVerificationFailed) Descriptor() ([]byte, []int) {
+	return file_events_integration_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IntegrationVerificationFailed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IntegrationVerificationFailed) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *IntegrationVerificationFailed) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *IntegrationVerificationFailed) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *IntegrationVerificationFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *IntegrationVerificationFailed) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *IntegrationVerificationFailed) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+var File_events_integration_proto protoreflect.FileDescriptor
+
+const file_events_integration_proto_rawDesc = "" +
+	"\n" +
+	"\x18events/integration.proto\x12\x12metacode.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdd\x01\n" +
+	"\x18IntegrationAccountLinked\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1a\n" +
+	"\bverified\x18\x05 \x01(\bR\bverified\x127\n" +
+	"\tlinked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\blinkedAt\"\xdf\x01\n" +
+	"\x1aIntegrationAccountUnlinked\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12;\n" +
+	"\vunlinked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"unlinkedAt\"\xcd\x01\n" +
+	" IntegrationVerificationSucceeded\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12;\n" +
+	"\vverified_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\"\xfd\x01\n" +
+	"\x1dIntegrationVerificationFailed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x06 \x01(\tR\terrorCode\x127\n" +
+	"\tfailed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAtB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_integration_proto_rawDescOnce sync.Once
+	file_events_integration_proto_rawDescData []byte
+)
+
+func file_events_integration_proto_rawDescGZIP() []byte {
+	file_events_integration_proto_rawDescOnce.Do(func() {
+		file_events_integration_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_integration_proto_rawDesc), len(file_events_integration_proto_rawDesc)))
+	})
+	return file_events_integration_proto_rawDescData
+}
+
+var file_events_integration_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_events_integration_proto_goTypes = []any{
+	(*IntegrationAccountLinked)(nil),         // 0: metacode.events.v1.IntegrationAccountLinked
+	(*IntegrationAccountUnlinked)(nil),       // 1: metacode.events.v1.IntegrationAccountUnlinked
+	(*IntegrationVerificationSucceeded)(nil), // 2: metacode.events.v1.IntegrationVerificationSucceeded
+	(*IntegrationVerificationFailed)(nil),    // 3: metacode.events.v1.IntegrationVerificationFailed
+	(*timestamppb.Timestamp)(nil),            // 4: google.protobuf.Timestamp
+}
+var file_events_integration_proto_depIdxs = []int32{
+	4, // 0: metacode.events.v1.IntegrationAccountLinked.linked_at:type_name -> google.protobuf.Timestamp
+	4, // 1: metacode.events.v1.IntegrationAccountUnlinked.unlinked_at:type_name -> google.protobuf.Timestamp
+	4, // 2: metacode.events.v1.IntegrationVerificationSucceeded.verified_at:type_name -> google.protobuf.Timestamp
+	4, // 3: metacode.events.v1.IntegrationVerificationFailed.failed_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_events_integration_proto_init() }
+func file_events_integration_proto_init() {
+	if File_events_integration_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_integration_proto_rawDesc), len(file_events_integration_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_integration_proto_goTypes,
+		DependencyIndexes: file_events_integration_proto_depIdxs,
+		MessageInfos:      file_events_integration_proto_msgTypes,
+	}.Build()
+	File_events_integration_proto = out.File
+	file_events_integration_proto_goTypes = nil
+	file_events_integration_proto_depIdxs = nil
+}
@@ -0,0 +1,776 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/leetcode.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LeetCodeAccountBound struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LeetcodeUsername string                 `protobuf:"bytes,2,opt,name=leetcode_username,json=leetcodeUsername,proto3" json:"leetcode_username,omitempty"`
+	BoundAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=bound_at,json=boundAt,proto3" json:"bound_at,omitempty"`
+	Verified         bool                   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeetCodeAccountBound) Reset() {
+	*x = LeetCodeAccountBound{}
+	mi := &file_events_leetcode_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeetCodeAccountBound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeetCodeAccountBound) ProtoMessage() {}
+
+func (x *LeetCodeAccountBound) ProtoReflect() protoreflect.Message {
+	mi := &file_events_leetcode_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeetCodeAccountBound.ProtoReflect.Descriptor instead.
+func (*LeetCodeAccountBound) Descriptor() ([]byte, []int) {
+	return file_events_leetcode_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LeetCodeAccountBound) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeetCodeAccountBound) GetLeetcodeUsername() string {
+	if x != nil {
+		return x.LeetcodeUsername
+	}
+	return ""
+}
+
+func (x *LeetCodeAccountBound) GetBoundAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BoundAt
+	}
+	return nil
+}
+
+func (x *LeetCodeAccountBound) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type LeetCodeAccountUnbound struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LeetcodeUsername string                 `protobuf:"bytes,2,opt,name=leetcode_username,json=leetcodeUsername,proto3" json:"leetcode_username,omitempty"`
+	UnboundAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=unbound_at,json=unboundAt,proto3" json:"unbound_at,omitempty"`
+	Reason           string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeetCodeAccountUnbound) Reset() {
+	*x = LeetCodeAccountUnbound{}
+	mi := &file_events_leetcode_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeetCodeAccountUnbound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeetCodeAccountUnbound) ProtoMessage() {}
+
+func (x *LeetCodeAccountUnbound) ProtoReflect() protoreflect.Message {
+	mi := &file_events_leetcode_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeetCodeAccountUnbound.ProtoReflect.Descriptor instead.
+func (*LeetCodeAccountUnbound) Descriptor() ([]byte, []int) {
+	return file_events_leetcode_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LeetCodeAccountUnbound) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeetCodeAccountUnbound) GetLeetcodeUsername() string {
+	if x != nil {
+		return x.LeetcodeUsername
+	}
+	return ""
+}
+
+func (x *LeetCodeAccountUnbound) GetUnboundAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnboundAt
+	}
+	return nil
+}
+
+func (x *LeetCodeAccountUnbound) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type LeetCodeVerificationSucceeded struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LeetcodeUsername string                 `protobuf:"bytes,2,opt,name=leetcode_username,json=leetcodeUsername,proto3" json:"leetcode_username,omitempty"`
+	VerifiedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeetCodeVerificationSucceeded) Reset() {
+	*x = LeetCodeVerificationSucceeded{}
+	mi := &file_events_leetcode_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeetCodeVerificationSucceeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeetCodeVerificationSucceeded) ProtoMessage() {}
+
+func (x *LeetCodeVerificationSucceeded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_leetcode_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeetCodeVerificationSucceeded.ProtoReflect.Descriptor instead.
+func (*LeetCodeVerificationSucceeded) Descriptor() ([]byte, []int) {
+	return file_events_leetcode_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LeetCodeVerificationSucceeded) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeetCodeVerificationSucceeded) GetLeetcodeUsername() string {
+	if x != nil {
+		return x.LeetcodeUsername
+	}
+	return ""
+}
+
+func (x *LeetCodeVerificationSucceeded) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+type LeetCodeVerificationFailed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LeetcodeUsername string                 `protobuf:"bytes,2,opt,name=leetcode_username,json=leetcodeUsername,proto3" json:"leetcode_username,omitempty"`
+	FailedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	Reason           string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ErrorCode        string                 `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeetCodeVerificationFailed) Reset() {
+	*x = LeetCodeVerificationFailed{}
+	mi := &file_events_leetcode_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeetCodeVerificationFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeetCodeVerificationFailed) ProtoMessage() {}
+
+func (x *LeetCodeVerificationFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_leetcode_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeetCodeVerificationFailed.ProtoReflect.Descriptor instead.
+func (*LeetCodeVerificationFailed) Descriptor() ([]byte, []int) {
+	return file_events_leetcode_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LeetCodeVerificationFailed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeetCodeVerificationFailed) GetLeetcodeUsername() string {
+	if x != nil {
+		return x.LeetcodeUsername
+	}
+	return ""
+}
+
+func (x *LeetCodeVerificationFailed) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+func (x *LeetCodeVerificationFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LeetCodeVerificationFailed) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+type LeetCodeProfileUpdated struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LeetcodeUsername string                 `protobuf:"bytes,2,opt,name=leetcode_username,json=leetcodeUsername,proto3" json:"leetcode_username,omitempty"`
+	TotalSolved      int32                  `protobuf:"varint,3,opt,name=total_solved,json=totalSolved,proto3" json:"total_solved,omitempty"`
+	EasySolved       int32                  `protobuf:"varint,4,opt,name=easy_solved,json=easySolved,proto3" json:"easy_solved,omitempty"`
+	MediumSolved     int32                  `protobuf:"varint,5,opt,name=medium_solved,json=mediumSolved,proto3" json:"medium_solved,omitempty"`
+	HardSolved       int32                  `protobuf:"varint,6,opt,name=hard_solved,json=hardSolved,proto3" json:"hard_solved,omitempty"`
+	Verified         bool                   `protobuf:"varint,7,opt,name=verified,proto3" json:"verified,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ChangeReason     string                 `protobuf:"bytes,9,opt,name=change_reason,json=changeReason,proto3" json:"change_reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeetCodeProfileUpdated) Reset() {
+	*x = LeetCodeProfileUpdated{}
+	mi := &file_events_leetcode_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeetCodeProfileUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeetCodeProfileUpdated) ProtoMessage() {}
+
+func (x *LeetCodeProfileUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_leetcode_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeetCodeProfileUpdated.ProtoReflect.Descriptor instead.
+func (*LeetCodeProfileUpdated) Descriptor() ([]byte, []int) {
+	return file_events_leetcode_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LeetCodeProfileUpdated) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeetCodeProfileUpdated) GetLeetcodeUsername() string {
+	if x != nil {
+		return x.LeetcodeUsername
+	}
+	return ""
+}
+
+func (x *LeetCodeProfileUpdated) GetTotalSolved() int32 {
+	if x != nil {
+		return x.TotalSolved
+	}
+	return 0
+}
+
+func (x *LeetCodeProfileUpdated) GetEasySolved() int32 {
+	if x != nil {
+		return x.EasySolved
+	}
+	return 0
+}
+
+func (x *LeetCodeProfileUpdated) GetMediumSolved() int32 {
+	if x != nil {
+		return x.MediumSolved
+	}
+	return 0
+}
+
+func (x *LeetCodeProfileUpdated) GetHardSolved() int32 {
+	if x != nil {
+		return x.HardSolved
+	}
+	return 0
+}
+
+func (x *LeetCodeProfileUpdated) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *LeetCodeProfileUpdated) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *LeetCodeProfileUpdated) GetChangeReason() string {
+	if x != nil {
+		return x.ChangeReason
+	}
+	return ""
+}
+
+type LeetCodeHistoryImportCompleted struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LeetcodeUsername string                 `protobuf:"bytes,2,opt,name=leetcode_username,json=leetcodeUsername,proto3" json:"leetcode_username,omitempty"`
+	YearsImported    []int32                `protobuf:"varint,3,rep,packed,name=years_imported,json=yearsImported,proto3" json:"years_imported,omitempty"`
+	TotalYears       int32                  `protobuf:"varint,4,opt,name=total_years,json=totalYears,proto3" json:"total_years,omitempty"`
+	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeetCodeHistoryImportCompleted) Reset() {
+	*x = LeetCodeHistoryImportCompleted{}
+	mi := &file_events_leetcode_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeetCodeHistoryImportCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeetCodeHistoryImportCompleted) ProtoMessage() {}
+
+func (x *LeetCodeHistoryImportCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_leetcode_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeetCodeHistoryImportCompleted.ProtoReflect.Descriptor instead.
+func (*LeetCodeHistoryImportCompleted) Descriptor() ([]byte, []int) {
+	return file_events_leetcode_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LeetCodeHistoryImportCompleted) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeetCodeHistoryImportCompleted) GetLeetcodeUsername() string {
+	if x != nil {
+		return x.LeetcodeUsername
+	}
+	return ""
+}
+
+func (x *LeetCodeHistoryImportCompleted) GetYearsImported() []int32 {
+	if x != nil {
+		return x.YearsImported
+	}
+	return nil
+}
+
+func (x *LeetCodeHistoryImportCompleted) GetTotalYears() int32 {
+	if x != nil {
+		return x.TotalYears
+	}
+	return 0
+}
+
+func (x *LeetCodeHistoryImportCompleted) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type LeetCodeHistoryImportFailed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LeetcodeUsername string                 `protobuf:"bytes,2,opt,name=leetcode_username,json=leetcodeUsername,proto3" json:"leetcode_username,omitempty"`
+	YearsAttempted   []int32                `protobuf:"varint,3,rep,packed,name=years_attempted,json=yearsAttempted,proto3" json:"years_attempted,omitempty"`
+	Error            string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode        string                 `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	FailedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeetCodeHistoryImportFailed) Reset() {
+	*x = LeetCodeHistoryImportFailed{}
+	mi := &file_events_leetcode_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeetCodeHistoryImportFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeetCodeHistoryImportFailed) ProtoMessage() {}
+
+func (x *LeetCodeHistoryImportFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_leetcode_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeetCodeHistoryImportFailed.ProtoReflect.Descriptor instead.
+func (*LeetCodeHistoryImportFailed) Descriptor() ([]byte, []int) {
+	return file_events_leetcode_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LeetCodeHistoryImportFailed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeetCodeHistoryImportFailed) GetLeetcodeUsername() string {
+	if x != nil {
+		return x.LeetcodeUsername
+	}
+	return ""
+}
+
+func (x *LeetCodeHistoryImportFailed) GetYearsAttempted() []int32 {
+	if x != nil {
+		return x.YearsAttempted
+	}
+	return nil
+}
+
+func (x *LeetCodeHistoryImportFailed) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LeetCodeHistoryImportFailed) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *LeetCodeHistoryImportFailed) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+type LeetCodeCurrentYearRefreshed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LeetcodeUsername string                 `protobuf:"bytes,2,opt,name=leetcode_username,json=leetcodeUsername,proto3" json:"leetcode_username,omitempty"`
+	Year             int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	QuestionsSolved  int32                  `protobuf:"varint,4,opt,name=questions_solved,json=questionsSolved,proto3" json:"questions_solved,omitempty"`
+	ActiveDays       int32                  `protobuf:"varint,5,opt,name=active_days,json=activeDays,proto3" json:"active_days,omitempty"`
+	RefreshedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeetCodeCurrentYearRefreshed) Reset() {
+	*x = LeetCodeCurrentYearRefreshed{}
+	mi := &file_events_leetcode_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeetCodeCurrentYearRefreshed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeetCodeCurrentYearRefreshed) ProtoMessage() {}
+
+func (x *LeetCodeCurrentYearRefreshed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_leetcode_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeetCodeCurrentYearRefreshed.ProtoReflect.Descriptor instead.
+func (*LeetCodeCurrentYearRefreshed) Descriptor() ([]byte, []int) {
+	return file_events_leetcode_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LeetCodeCurrentYearRefreshed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeetCodeCurrentYearRefreshed) GetLeetcodeUsername() string {
+	if x != nil {
+		return x.LeetcodeUsername
+	}
+	return ""
+}
+
+func (x *LeetCodeCurrentYearRefreshed) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *LeetCodeCurrentYearRefreshed) GetQuestionsSolved() int32 {
+	if x != nil {
+		return x.QuestionsSolved
+	}
+	return 0
+}
+
+func (x *LeetCodeCurrentYearRefreshed) GetActiveDays() int32 {
+	if x != nil {
+		return x.ActiveDays
+	}
+	return 0
+}
+
+func (x *LeetCodeCurrentYearRefreshed) GetRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return nil
+}
+
+var File_events_leetcode_proto protoreflect.FileDescriptor
+
+const file_events_leetcode_proto_rawDesc = "" +
+	"\n" +
+	"\x15events/leetcode.proto\x12\x12metacode.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\x01\n" +
+	"\x14LeetCodeAccountBound\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11leetcode_username\x18\x02 \x01(\tR\x10leetcodeUsername\x125\n" +
+	"\bbound_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aboundAt\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\bR\bverified\"\xb1\x01\n" +
+	"\x16LeetCodeAccountUnbound\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11leetcode_username\x18\x02 \x01(\tR\x10leetcodeUsername\x129\n" +
+	"\n" +
+	"unbound_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tunboundAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xa2\x01\n" +
+	"\x1dLeetCodeVerificationSucceeded\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11leetcode_username\x18\x02 \x01(\tR\x10leetcodeUsername\x12;\n" +
+	"\vverified_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\"\xd2\x01\n" +
+	"\x1aLeetCodeVerificationFailed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11leetcode_username\x18\x02 \x01(\tR\x10leetcodeUsername\x127\n" +
+	"\tfailed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\tR\terrorCode\"\xe4\x02\n" +
+	"\x16LeetCodeProfileUpdated\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11leetcode_username\x18\x02 \x01(\tR\x10leetcodeUsername\x12!\n" +
+	"\ftotal_solved\x18\x03 \x01(\x05R\vtotalSolved\x12\x1f\n" +
+	"\veasy_solved\x18\x04 \x01(\x05R\n" +
+	"easySolved\x12#\n" +
+	"\rmedium_solved\x18\x05 \x01(\x05R\fmediumSolved\x12\x1f\n" +
+	"\vhard_solved\x18\x06 \x01(\x05R\n" +
+	"hardSolved\x12\x1a\n" +
+	"\bverified\x18\a \x01(\bR\bverified\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rchange_reason\x18\t \x01(\tR\fchangeReason\"\xed\x01\n" +
+	"\x1eLeetCodeHistoryImportCompleted\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11leetcode_username\x18\x02 \x01(\tR\x10leetcodeUsername\x12%\n" +
+	"\x0eyears_imported\x18\x03 \x03(\x05R\ryearsImported\x12\x1f\n" +
+	"\vtotal_years\x18\x04 \x01(\x05R\n" +
+	"totalYears\x12=\n" +
+	"\fcompleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xfa\x01\n" +
+	"\x1bLeetCodeHistoryImportFailed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11leetcode_username\x18\x02 \x01(\tR\x10leetcodeUsername\x12'\n" +
+	"\x0fyears_attempted\x18\x03 \x03(\x05R\x0eyearsAttempted\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\tR\terrorCode\x127\n" +
+	"\tfailed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\"\x83\x02\n" +
+	"\x1cLeetCodeCurrentYearRefreshed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x11leetcode_username\x18\x02 \x01(\tR\x10leetcodeUsername\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12)\n" +
+	"\x10questions_solved\x18\x04 \x01(\x05R\x0fquestionsSolved\x12\x1f\n" +
+	"\vactive_days\x18\x05 \x01(\x05R\n" +
+	"activeDays\x12=\n" +
+	"\frefreshed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vrefreshedAtB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_leetcode_proto_rawDescOnce sync.Once
+	file_events_leetcode_proto_rawDescData []byte
+)
+
+func file_events_leetcode_proto_rawDescGZIP() []byte {
+	file_events_leetcode_proto_rawDescOnce.Do(func() {
+		file_events_leetcode_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_leetcode_proto_rawDesc), len(file_events_leetcode_proto_rawDesc)))
+	})
+	return file_events_leetcode_proto_rawDescData
+}
+
+var file_events_leetcode_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_events_leetcode_proto_goTypes = []any{
+	(*LeetCodeAccountBound)(nil),           // 0: metacode.events.v1.LeetCodeAccountBound
+	(*LeetCodeAccountUnbound)(nil),         // 1: metacode.events.v1.LeetCodeAccountUnbound
+	(*LeetCodeVerificationSucceeded)(nil),  // 2: metacode.events.v1.LeetCodeVerificationSucceeded
+	(*LeetCodeVerificationFailed)(nil),     // 3: metacode.events.v1.LeetCodeVerificationFailed
+	(*LeetCodeProfileUpdated)(nil),         // 4: metacode.events.v1.LeetCodeProfileUpdated
+	(*LeetCodeHistoryImportCompleted)(nil), // 5: metacode.events.v1.LeetCodeHistoryImportCompleted
+	(*LeetCodeHistoryImportFailed)(nil),    // 6: metacode.events.v1.LeetCodeHistoryImportFailed
+	(*LeetCodeCurrentYearRefreshed)(nil),   // 7: metacode.events.v1.LeetCodeCurrentYearRefreshed
+	(*timestamppb.Timestamp)(nil),          // 8: google.protobuf.Timestamp
+}
+var file_events_leetcode_proto_depIdxs = []int32{
+	8, // 0: metacode.events.v1.LeetCodeAccountBound.bound_at:type_name -> google.protobuf.Timestamp
+	8, // 1: metacode.events.v1.LeetCodeAccountUnbound.unbound_at:type_name -> google.protobuf.Timestamp
+	8, // 2: metacode.events.v1.LeetCodeVerificationSucceeded.verified_at:type_name -> google.protobuf.Timestamp
+	8, // 3: metacode.events.v1.LeetCodeVerificationFailed.failed_at:type_name -> google.protobuf.Timestamp
+	8, // 4: metacode.events.v1.LeetCodeProfileUpdated.updated_at:type_name -> google.protobuf.Timestamp
+	8, // 5: metacode.events.v1.LeetCodeHistoryImportCompleted.completed_at:type_name -> google.protobuf.Timestamp
+	8, // 6: metacode.events.v1.LeetCodeHistoryImportFailed.failed_at:type_name -> google.protobuf.Timestamp
+	8, // 7: metacode.events.v1.LeetCodeCurrentYearRefreshed.refreshed_at:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_events_leetcode_proto_init() }
+func file_events_leetcode_proto_init() {
+	if File_events_leetcode_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_leetcode_proto_rawDesc), len(file_events_leetcode_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_leetcode_proto_goTypes,
+		DependencyIndexes: file_events_leetcode_proto_depIdxs,
+		MessageInfos:      file_events_leetcode_proto_msgTypes,
+	}.Build()
+	File_events_leetcode_proto = out.File
+	file_events_leetcode_proto_goTypes = nil
+	file_events_leetcode_proto_depIdxs = nil
+}
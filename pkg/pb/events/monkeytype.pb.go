@@ -0,0 +1,1004 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/monkeytype.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MonkeytypeAccountBound struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MonkeytypeUsername string                 `protobuf:"bytes,2,opt,name=monkeytype_username,json=monkeytypeUsername,proto3" json:"monkeytype_username,omitempty"`
+	BoundAt            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=bound_at,json=boundAt,proto3" json:"bound_at,omitempty"`
+	Verified           bool                   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MonkeytypeAccountBound) Reset() {
+	*x = MonkeytypeAccountBound{}
+	mi := &file_events_monkeytype_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonkeytypeAccountBound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonkeytypeAccountBound) ProtoMessage() {}
+
+func (x *MonkeytypeAccountBound) ProtoReflect() protoreflect.Message {
+	mi := &file_events_monkeytype_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonkeytypeAccountBound.ProtoReflect.Descriptor instead.
+func (*MonkeytypeAccountBound) Descriptor() ([]byte, []int) {
+	return file_events_monkeytype_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MonkeytypeAccountBound) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MonkeytypeAccountBound) GetMonkeytypeUsername() string {
+	if x != nil {
+		return x.MonkeytypeUsername
+	}
+	return ""
+}
+
+func (x *MonkeytypeAccountBound) GetBoundAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BoundAt
+	}
+	return nil
+}
+
+func (x *MonkeytypeAccountBound) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type MonkeytypeAccountUnbound struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MonkeytypeUsername string                 `protobuf:"bytes,2,opt,name=monkeytype_username,json=monkeytypeUsername,proto3" json:"monkeytype_username,omitempty"`
+	UnboundAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=unbound_at,json=unboundAt,proto3" json:"unbound_at,omitempty"`
+	Reason             string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MonkeytypeAccountUnbound) Reset() {
+	*x = MonkeytypeAccountUnbound{}
+	mi := &file_events_monkeytype_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonkeytypeAccountUnbound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonkeytypeAccountUnbound) ProtoMessage() {}
+
+func (x *MonkeytypeAccountUnbound) ProtoReflect() protoreflect.Message {
+	mi := &file_events_monkeytype_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonkeytypeAccountUnbound.ProtoReflect.Descriptor instead.
+func (*MonkeytypeAccountUnbound) Descriptor() ([]byte, []int) {
+	return file_events_monkeytype_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MonkeytypeAccountUnbound) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MonkeytypeAccountUnbound) GetMonkeytypeUsername() string {
+	if x != nil {
+		return x.MonkeytypeUsername
+	}
+	return ""
+}
+
+func (x *MonkeytypeAccountUnbound) GetUnboundAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnboundAt
+	}
+	return nil
+}
+
+func (x *MonkeytypeAccountUnbound) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MonkeytypeVerificationSucceeded struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MonkeytypeUsername string                 `protobuf:"bytes,2,opt,name=monkeytype_username,json=monkeytypeUsername,proto3" json:"monkeytype_username,omitempty"`
+	VerifiedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MonkeytypeVerificationSucceeded) Reset() {
+	*x = MonkeytypeVerificationSucceeded{}
+	mi := &file_events_monkeytype_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonkeytypeVerificationSucceeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonkeytypeVerificationSucceeded) ProtoMessage() {}
+
+func (x *MonkeytypeVerificationSucceeded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_monkeytype_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonkeytypeVerificationSucceeded.ProtoReflect.Descriptor instead.
+func (*MonkeytypeVerificationSucceeded) Descriptor() ([]byte, []int) {
+	return file_events_monkeytype_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MonkeytypeVerificationSucceeded) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MonkeytypeVerificationSucceeded) GetMonkeytypeUsername() string {
+	if x != nil {
+		return x.MonkeytypeUsername
+	}
+	return ""
+}
+
+func (x *MonkeytypeVerificationSucceeded) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+type MonkeytypeVerificationFailed struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MonkeytypeUsername string                 `protobuf:"bytes,2,opt,name=monkeytype_username,json=monkeytypeUsername,proto3" json:"monkeytype_username,omitempty"`
+	FailedAt           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	Reason             string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ErrorCode          string                 `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MonkeytypeVerificationFailed) Reset() {
+	*x = MonkeytypeVerificationFailed{}
+	mi := &file_events_monkeytype_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonkeytypeVerificationFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonkeytypeVerificationFailed) ProtoMessage() {}
+
+func (x *MonkeytypeVerificationFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_monkeytype_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonkeytypeVerificationFailed.ProtoReflect.Descriptor instead.
+func (*MonkeytypeVerificationFailed) Descriptor() ([]byte, []int) {
+	return file_events_monkeytype_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MonkeytypeVerificationFailed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MonkeytypeVerificationFailed) GetMonkeytypeUsername() string {
+	if x != nil {
+		return x.MonkeytypeUsername
+	}
+	return ""
+}
+
+func (x *MonkeytypeVerificationFailed) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+func (x *MonkeytypeVerificationFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MonkeytypeVerificationFailed) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+type MonkeytypeProfileUpdated struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MonkeytypeUsername string                 `protobuf:"bytes,2,opt,name=monkeytype_username,json=monkeytypeUsername,proto3" json:"monkeytype_username,omitempty"`
+	WpmBest            float64                `protobuf:"fixed64,3,opt,name=wpm_best,json=wpmBest,proto3" json:"wpm_best,omitempty"`
+	AccuracyBest       float64                `protobuf:"fixed64,4,opt,name=accuracy_best,json=accuracyBest,proto3" json:"accuracy_best,omitempty"`
+	TestsCompleted     int32                  `protobuf:"varint,5,opt,name=tests_completed,json=testsCompleted,proto3" json:"tests_completed,omitempty"`
+	Verified           bool                   `protobuf:"varint,6,opt,name=verified,proto3" json:"verified,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ChangeReason       string                 `protobuf:"bytes,8,opt,name=change_reason,json=changeReason,proto3" json:"change_reason,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MonkeytypeProfileUpdated) Reset() {
+	*x = MonkeytypeProfileUpdated{}
+	mi := &file_events_monkeytype_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonkeytypeProfileUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonkeytypeProfileUpdated) ProtoMessage() {}
+
+func (x *MonkeytypeProfileUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_monkeytype_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonkeytypeProfileUpdated.ProtoReflect.Descriptor instead.
+func (*MonkeytypeProfileUpdated) Descriptor() ([]byte, []int) {
+	return file_events_monkeytype_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MonkeytypeProfileUpdated) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MonkeytypeProfileUpdated) GetMonkeytypeUsername() string {
+	if x != nil {
+		return x.MonkeytypeUsername
+	}
+	return ""
+}
+
+func (x *MonkeytypeProfileUpdated) GetWpmBest() float64 {
+	if x != nil {
+		return x.WpmBest
+	}
+	return 0
+}
+
+func (x *MonkeytypeProfileUpdated) GetAccuracyBest() float64 {
+	if x != nil {
+		return x.AccuracyBest
+	}
+	return 0
+}
+
+func (x *MonkeytypeProfileUpdated) GetTestsCompleted() int32 {
+	if x != nil {
+		return x.TestsCompleted
+	}
+	return 0
+}
+
+func (x *MonkeytypeProfileUpdated) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *MonkeytypeProfileUpdated) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *MonkeytypeProfileUpdated) GetChangeReason() string {
+	if x != nil {
+		return x.ChangeReason
+	}
+	return ""
+}
+
+type MonkeytypeCurrentStatsRefreshed struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MonkeytypeUsername string                 `protobuf:"bytes,2,opt,name=monkeytype_username,json=monkeytypeUsername,proto3" json:"monkeytype_username,omitempty"`
+	TestsToday         int32                  `protobuf:"varint,3,opt,name=tests_today,json=testsToday,proto3" json:"tests_today,omitempty"`
+	RefreshedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	BestWpm            *MonkeytypeBestWpm     `protobuf:"bytes,5,opt,name=best_wpm,json=bestWpm,proto3" json:"best_wpm,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MonkeytypeCurrentStatsRefreshed) Reset() {
+	*x = MonkeytypeCurrentStatsRefreshed{}
+	mi := &file_events_monkeytype_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonkeytypeCurrentStatsRefreshed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonkeytypeCurrentStatsRefreshed) ProtoMessage() {}
+
+func (x *MonkeytypeCurrentStatsRefreshed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_monkeytype_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonkeytypeCurrentStatsRefreshed.ProtoReflect.Descriptor instead.
+func (*MonkeytypeCurrentStatsRefreshed) Descriptor() ([]byte, []int) {
+	return file_events_monkeytype_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MonkeytypeCurrentStatsRefreshed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MonkeytypeCurrentStatsRefreshed) GetMonkeytypeUsername() string {
+	if x != nil {
+		return x.MonkeytypeUsername
+	}
+	return ""
+}
+
+func (x *MonkeytypeCurrentStatsRefreshed) GetTestsToday() int32 {
+	if x != nil {
+		return x.TestsToday
+	}
+	return 0
+}
+
+func (x *MonkeytypeCurrentStatsRefreshed) GetRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return nil
+}
+
+func (x *MonkeytypeCurrentStatsRefreshed) GetBestWpm() *MonkeytypeBestWpm {
+	if x != nil {
+		return x.BestWpm
+	}
+	return nil
+}
+
+type MonkeytypeBestWpm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time_15S      float64                `protobuf:"fixed64,1,opt,name=time_15s,json=time15s,proto3" json:"time_15s,omitempty"`
+	Time_60S      float64                `protobuf:"fixed64,2,opt,name=time_60s,json=time60s,proto3" json:"time_60s,omitempty"`
+	Words         float64                `protobuf:"fixed64,3,opt,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonkeytypeBestWpm) Reset() {
+	*x = MonkeytypeBestWpm{}
+	mi := &file_events_monkeytype_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonkeytypeBestWpm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonkeytypeBestWpm) ProtoMessage() {}
+
+func (x *MonkeytypeBestWpm) ProtoReflect() protoreflect.Message {
+	mi := &file_events_monkeytype_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonkeytypeBestWpm.ProtoReflect.Descriptor instead.
+func (*MonkeytypeBestWpm) Descriptor() ([]byte, []int) {
+	return file_events_monkeytype_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MonkeytypeBestWpm) GetTime_15S() float64 {
+	if x != nil {
+		return x.Time_15S
+	}
+	return 0
+}
+
+func (x *MonkeytypeBestWpm) GetTime_60S() float64 {
+	if x != nil {
+		return x.Time_60S
+	}
+	return 0
+}
+
+func (x *MonkeytypeBestWpm) GetWords() float64 {
+	if x != nil {
+		return x.Words
+	}
+	return 0
+}
+
+type MonkeytypeDailyTests struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Tests         int32                  `protobuf:"varint,2,opt,name=tests,proto3" json:"tests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonkeytypeDailyTests) Reset() {
+	*x = MonkeytypeDailyTests{}
+	mi := &file_events_monkeytype_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonkeytypeDailyTests) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonkeytypeDailyTests) ProtoMessage() {}
+
+func (x *MonkeytypeDailyTests) ProtoReflect() protoreflect.Message {
+	mi := &file_events_monkeytype_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonkeytypeDailyTests.ProtoReflect.Descriptor instead.
+func (*MonkeytypeDailyTests) Descriptor() ([]byte, []int) {
+	return file_events_monkeytype_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MonkeytypeDailyTests) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MonkeytypeDailyTests) GetTests() int32 {
+	if x != nil {
+		return x.Tests
+	}
+	return 0
+}
+
+type MonkeytypeHistoryImportCompleted struct {
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	UserId             string                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MonkeytypeUsername string                  `protobuf:"bytes,2,opt,name=monkeytype_username,json=monkeytypeUsername,proto3" json:"monkeytype_username,omitempty"`
+	YearsImported      []int32                 `protobuf:"varint,3,rep,packed,name=years_imported,json=yearsImported,proto3" json:"years_imported,omitempty"`
+	TotalYears         int32                   `protobuf:"varint,4,opt,name=total_years,json=totalYears,proto3" json:"total_years,omitempty"`
+	DailyTests         []*MonkeytypeDailyTests `protobuf:"bytes,5,rep,name=daily_tests,json=dailyTests,proto3" json:"daily_tests,omitempty"`
+	CompletedAt        *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MonkeytypeHistoryImportCompleted) Reset() {
+	*x = MonkeytypeHistoryImportCompleted{}
+	mi := &file_events_monkeytype_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonkeytypeHistoryImportCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonkeytypeHistoryImportCompleted) ProtoMessage() {}
+
+func (x *MonkeytypeHistoryImportCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_monkeytype_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonkeytypeHistoryImportCompleted.ProtoReflect.Descriptor instead.
+func (*MonkeytypeHistoryImportCompleted) Descriptor() ([]byte, []int) {
+	return file_events_monkeytype_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MonkeytypeHistoryImportCompleted) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MonkeytypeHistoryImportCompleted) GetMonkeytypeUsername() string {
+	if x != nil {
+		return x.MonkeytypeUsername
+	}
+	return ""
+}
+
+func (x *MonkeytypeHistoryImportCompleted) GetYearsImported() []int32 {
+	if x != nil {
+		return x.YearsImported
+	}
+	return nil
+}
+
+func (x *MonkeytypeHistoryImportCompleted) GetTotalYears() int32 {
+	if x != nil {
+		return x.TotalYears
+	}
+	return 0
+}
+
+func (x *MonkeytypeHistoryImportCompleted) GetDailyTests() []*MonkeytypeDailyTests {
+	if x != nil {
+		return x.DailyTests
+	}
+	return nil
+}
+
+func (x *MonkeytypeHistoryImportCompleted) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type MonkeytypeHistoryImportFailed struct {
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	UserId             string                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MonkeytypeUsername string                  `protobuf:"bytes,2,opt,name=monkeytype_username,json=monkeytypeUsername,proto3" json:"monkeytype_username,omitempty"`
+	YearsAttempted     []int32                 `protobuf:"varint,3,rep,packed,name=years_attempted,json=yearsAttempted,proto3" json:"years_attempted,omitempty"`
+	DailyTests         []*MonkeytypeDailyTests `protobuf:"bytes,4,rep,name=daily_tests,json=dailyTests,proto3" json:"daily_tests,omitempty"`
+	Error              string                  `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode          string                  `protobuf:"bytes,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	FailedAt           *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MonkeytypeHistoryImportFailed) Reset() {
+	*x = MonkeytypeHistoryImportFailed{}
+	mi := &file_events_monkeytype_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonkeytypeHistoryImportFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonkeytypeHistoryImportFailed) ProtoMessage() {}
+
+func (x *MonkeytypeHistoryImportFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_monkeytype_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonkeytypeHistoryImportFailed.ProtoReflect.Descriptor instead.
+func (*MonkeytypeHistoryImportFailed) Descriptor() ([]byte, []int) {
+	return file_events_monkeytype_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MonkeytypeHistoryImportFailed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MonkeytypeHistoryImportFailed) GetMonkeytypeUsername() string {
+	if x != nil {
+		return x.MonkeytypeUsername
+	}
+	return ""
+}
+
+func (x *MonkeytypeHistoryImportFailed) GetYearsAttempted() []int32 {
+	if x != nil {
+		return x.YearsAttempted
+	}
+	return nil
+}
+
+func (x *MonkeytypeHistoryImportFailed) GetDailyTests() []*MonkeytypeDailyTests {
+	if x != nil {
+		return x.DailyTests
+	}
+	return nil
+}
+
+func (x *MonkeytypeHistoryImportFailed) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MonkeytypeHistoryImportFailed) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *MonkeytypeHistoryImportFailed) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+type MonkeytypeTodayContributed struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MonkeytypeUsername string                 `protobuf:"bytes,2,opt,name=monkeytype_username,json=monkeytypeUsername,proto3" json:"monkeytype_username,omitempty"`
+	Count              int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	TimeTyping         int32                  `protobuf:"varint,4,opt,name=time_typing,json=timeTyping,proto3" json:"time_typing,omitempty"`
+	Date               string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Timezone           string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MonkeytypeTodayContributed) Reset() {
+	*x = MonkeytypeTodayContributed{}
+	mi := &file_events_monkeytype_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonkeytypeTodayContributed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonkeytypeTodayContributed) ProtoMessage() {}
+
+func (x *MonkeytypeTodayContributed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_monkeytype_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonkeytypeTodayContributed.ProtoReflect.Descriptor instead.
+func (*MonkeytypeTodayContributed) Descriptor() ([]byte, []int) {
+	return file_events_monkeytype_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MonkeytypeTodayContributed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MonkeytypeTodayContributed) GetMonkeytypeUsername() string {
+	if x != nil {
+		return x.MonkeytypeUsername
+	}
+	return ""
+}
+
+func (x *MonkeytypeTodayContributed) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MonkeytypeTodayContributed) GetTimeTyping() int32 {
+	if x != nil {
+		return x.TimeTyping
+	}
+	return 0
+}
+
+func (x *MonkeytypeTodayContributed) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MonkeytypeTodayContributed) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *MonkeytypeTodayContributed) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_events_monkeytype_proto protoreflect.FileDescriptor
+
+const file_events_monkeytype_proto_rawDesc = "" +
+	"\n" +
+	"\x17events/monkeytype.proto\x12\x12metacode.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb5\x01\n" +
+	"\x16MonkeytypeAccountBound\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x13monkeytype_username\x18\x02 \x01(\tR\x12monkeytypeUsername\x125\n" +
+	"\bbound_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aboundAt\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\bR\bverified\"\xb7\x01\n" +
+	"\x18MonkeytypeAccountUnbound\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x13monkeytype_username\x18\x02 \x01(\tR\x12monkeytypeUsername\x129\n" +
+	"\n" +
+	"unbound_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tunboundAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xa8\x01\n" +
+	"\x1fMonkeytypeVerificationSucceeded\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x13monkeytype_username\x18\x02 \x01(\tR\x12monkeytypeUsername\x12;\n" +
+	"\vverified_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\"\xd8\x01\n" +
+	"\x1cMonkeytypeVerificationFailed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x13monkeytype_username\x18\x02 \x01(\tR\x12monkeytypeUsername\x127\n" +
+	"\tfailed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\tR\terrorCode\"\xc9\x02\n" +
+	"\x18MonkeytypeProfileUpdated\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x13monkeytype_username\x18\x02 \x01(\tR\x12monkeytypeUsername\x12\x19\n" +
+	"\bwpm_best\x18\x03 \x01(\x01R\awpmBest\x12#\n" +
+	"\raccuracy_best\x18\x04 \x01(\x01R\faccuracyBest\x12'\n" +
+	"\x0ftests_completed\x18\x05 \x01(\x05R\x0etestsCompleted\x12\x1a\n" +
+	"\bverified\x18\x06 \x01(\bR\bverified\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rchange_reason\x18\b \x01(\tR\fchangeReason\"\x8d\x02\n" +
+	"\x1fMonkeytypeCurrentStatsRefreshed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x13monkeytype_username\x18\x02 \x01(\tR\x12monkeytypeUsername\x12\x1f\n" +
+	"\vtests_today\x18\x03 \x01(\x05R\n" +
+	"testsToday\x12=\n" +
+	"\frefreshed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vrefreshedAt\x12@\n" +
+	"\bbest_wpm\x18\x05 \x01(\v2%.metacode.events.v1.MonkeytypeBestWpmR\abestWpm\"_\n" +
+	"\x11MonkeytypeBestWpm\x12\x19\n" +
+	"\btime_15s\x18\x01 \x01(\x01R\atime15s\x12\x19\n" +
+	"\btime_60s\x18\x02 \x01(\x01R\atime60s\x12\x14\n" +
+	"\x05words\x18\x03 \x01(\x01R\x05words\"@\n" +
+	"\x14MonkeytypeDailyTests\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05tests\x18\x02 \x01(\x05R\x05tests\"\xbe\x02\n" +
+	" MonkeytypeHistoryImportCompleted\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x13monkeytype_username\x18\x02 \x01(\tR\x12monkeytypeUsername\x12%\n" +
+	"\x0eyears_imported\x18\x03 \x03(\x05R\ryearsImported\x12\x1f\n" +
+	"\vtotal_years\x18\x04 \x01(\x05R\n" +
+	"totalYears\x12I\n" +
+	"\vdaily_tests\x18\x05 \x03(\v2(.metacode.events.v1.MonkeytypeDailyTestsR\n" +
+	"dailyTests\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xcb\x02\n" +
+	"\x1dMonkeytypeHistoryImportFailed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x13monkeytype_username\x18\x02 \x01(\tR\x12monkeytypeUsername\x12'\n" +
+	"\x0fyears_attempted\x18\x03 \x03(\x05R\x0eyearsAttempted\x12I\n" +
+	"\vdaily_tests\x18\x04 \x03(\v2(.metacode.events.v1.MonkeytypeDailyTestsR\n" +
+	"dailyTests\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x06 \x01(\tR\terrorCode\x127\n" +
+	"\tfailed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\"\x88\x02\n" +
+	"\x1aMonkeytypeTodayContributed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x13monkeytype_username\x18\x02 \x01(\tR\x12monkeytypeUsername\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x1f\n" +
+	"\vtime_typing\x18\x04 \x01(\x05R\n" +
+	"timeTyping\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_monkeytype_proto_rawDescOnce sync.Once
+	file_events_monkeytype_proto_rawDescData []byte
+)
+
+func file_events_monkeytype_proto_rawDescGZIP() []byte {
+	file_events_monkeytype_proto_rawDescOnce.Do(func() {
+		file_events_monkeytype_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_monkeytype_proto_rawDesc), len(file_events_monkeytype_proto_rawDesc)))
+	})
+	return file_events_monkeytype_proto_rawDescData
+}
+
+var file_events_monkeytype_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_events_monkeytype_proto_goTypes = []any{
+	(*MonkeytypeAccountBound)(nil),           // 0: metacode.events.v1.MonkeytypeAccountBound
+	(*MonkeytypeAccountUnbound)(nil),         // 1: metacode.events.v1.MonkeytypeAccountUnbound
+	(*MonkeytypeVerificationSucceeded)(nil),  // 2: metacode.events.v1.MonkeytypeVerificationSucceeded
+	(*MonkeytypeVerificationFailed)(nil),     // 3: metacode.events.v1.MonkeytypeVerificationFailed
+	(*MonkeytypeProfileUpdated)(nil),         // 4: metacode.events.v1.MonkeytypeProfileUpdated
+	(*MonkeytypeCurrentStatsRefreshed)(nil),  // 5: metacode.events.v1.MonkeytypeCurrentStatsRefreshed
+	(*MonkeytypeBestWpm)(nil),                // 6: metacode.events.v1.MonkeytypeBestWpm
+	(*MonkeytypeDailyTests)(nil),             // 7: metacode.events.v1.MonkeytypeDailyTests
+	(*MonkeytypeHistoryImportCompleted)(nil), // 8: metacode.events.v1.MonkeytypeHistoryImportCompleted
+	(*MonkeytypeHistoryImportFailed)(nil),    // 9: metacode.events.v1.MonkeytypeHistoryImportFailed
+	(*MonkeytypeTodayContributed)(nil),       // 10: metacode.events.v1.MonkeytypeTodayContributed
+	(*timestamppb.Timestamp)(nil),            // 11: google.protobuf.Timestamp
+}
+var file_events_monkeytype_proto_depIdxs = []int32{
+	11, // 0: metacode.events.v1.MonkeytypeAccountBound.bound_at:type_name -> google.protobuf.Timestamp
+	11, // 1: metacode.events.v1.MonkeytypeAccountUnbound.unbound_at:type_name -> google.protobuf.Timestamp
+	11, // 2: metacode.events.v1.MonkeytypeVerificationSucceeded.verified_at:type_name -> google.protobuf.Timestamp
+	11, // 3: metacode.events.v1.MonkeytypeVerificationFailed.failed_at:type_name -> google.protobuf.Timestamp
+	11, // 4: metacode.events.v1.MonkeytypeProfileUpdated.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 5: metacode.events.v1.MonkeytypeCurrentStatsRefreshed.refreshed_at:type_name -> google.protobuf.Timestamp
+	6,  // 6: metacode.events.v1.MonkeytypeCurrentStatsRefreshed.best_wpm:type_name -> metacode.events.v1.MonkeytypeBestWpm
+	7,  // 7: metacode.events.v1.MonkeytypeHistoryImportCompleted.daily_tests:type_name -> metacode.events.v1.MonkeytypeDailyTests
+	11, // 8: metacode.events.v1.MonkeytypeHistoryImportCompleted.completed_at:type_name -> google.protobuf.Timestamp
+	7,  // 9: metacode.events.v1.MonkeytypeHistoryImportFailed.daily_tests:type_name -> metacode.events.v1.MonkeytypeDailyTests
+	11, // 10: metacode.events.v1.MonkeytypeHistoryImportFailed.failed_at:type_name -> google.protobuf.Timestamp
+	11, // 11: metacode.events.v1.MonkeytypeTodayContributed.updated_at:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_events_monkeytype_proto_init() }
+func file_events_monkeytype_proto_init() {
+	if File_events_monkeytype_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_monkeytype_proto_rawDesc), len(file_events_monkeytype_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_monkeytype_proto_goTypes,
+		DependencyIndexes: file_events_monkeytype_proto_depIdxs,
+		MessageInfos:      file_events_monkeytype_proto_msgTypes,
+	}.Build()
+	File_events_monkeytype_proto = out.File
+	file_events_monkeytype_proto_goTypes = nil
+	file_events_monkeytype_proto_depIdxs = nil
+}
@@ -0,0 +1,398 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/streak.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StreakExtended struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Length        int32                  `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	ExtendedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=extended_at,json=extendedAt,proto3" json:"extended_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreakExtended) Reset() {
+	*x = StreakExtended{}
+	mi := &file_events_streak_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreakExtended) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreakExtended) ProtoMessage() {}
+
+func (x *StreakExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_events_streak_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreakExtended.ProtoReflect.Descriptor instead.
+func (*StreakExtended) Descriptor() ([]byte, []int) {
+	return file_events_streak_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreakExtended) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreakExtended) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *StreakExtended) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *StreakExtended) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *StreakExtended) GetExtendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExtendedAt
+	}
+	return nil
+}
+
+type StreakBroken struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Length         int32                  `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	LastActiveDate string                 `protobuf:"bytes,3,opt,name=last_active_date,json=lastActiveDate,proto3" json:"last_active_date,omitempty"`
+	BrokenAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=broken_at,json=brokenAt,proto3" json:"broken_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreakBroken) Reset() {
+	*x = StreakBroken{}
+	mi := &file_events_streak_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreakBroken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreakBroken) ProtoMessage() {}
+
+func (x *StreakBroken) ProtoReflect() protoreflect.Message {
+	mi := &file_events_streak_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreakBroken.ProtoReflect.Descriptor instead.
+func (*StreakBroken) Descriptor() ([]byte, []int) {
+	return file_events_streak_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreakBroken) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreakBroken) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *StreakBroken) GetLastActiveDate() string {
+	if x != nil {
+		return x.LastActiveDate
+	}
+	return ""
+}
+
+func (x *StreakBroken) GetBrokenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BrokenAt
+	}
+	return nil
+}
+
+type StreakFrozen struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Length        int32                  `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	FrozenDate    string                 `protobuf:"bytes,3,opt,name=frozen_date,json=frozenDate,proto3" json:"frozen_date,omitempty"`
+	FreezesLeft   int32                  `protobuf:"varint,4,opt,name=freezes_left,json=freezesLeft,proto3" json:"freezes_left,omitempty"`
+	FrozenAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreakFrozen) Reset() {
+	*x = StreakFrozen{}
+	mi := &file_events_streak_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreakFrozen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreakFrozen) ProtoMessage() {}
+
+func (x *StreakFrozen) ProtoReflect() protoreflect.Message {
+	mi := &file_events_streak_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreakFrozen.ProtoReflect.Descriptor instead.
+func (*StreakFrozen) Descriptor() ([]byte, []int) {
+	return file_events_streak_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreakFrozen) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreakFrozen) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *StreakFrozen) GetFrozenDate() string {
+	if x != nil {
+		return x.FrozenDate
+	}
+	return ""
+}
+
+func (x *StreakFrozen) GetFreezesLeft() int32 {
+	if x != nil {
+		return x.FreezesLeft
+	}
+	return 0
+}
+
+func (x *StreakFrozen) GetFrozenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FrozenAt
+	}
+	return nil
+}
+
+type StreakMilestone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Milestone     int32                  `protobuf:"varint,2,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	ReachedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reached_at,json=reachedAt,proto3" json:"reached_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreakMilestone) Reset() {
+	*x = StreakMilestone{}
+	mi := &file_events_streak_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreakMilestone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreakMilestone) ProtoMessage() {}
+
+func (x *StreakMilestone) ProtoReflect() protoreflect.Message {
+	mi := &file_events_streak_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreakMilestone.ProtoReflect.Descriptor instead.
+func (*StreakMilestone) Descriptor() ([]byte, []int) {
+	return file_events_streak_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreakMilestone) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreakMilestone) GetMilestone() int32 {
+	if x != nil {
+		return x.Milestone
+	}
+	return 0
+}
+
+func (x *StreakMilestone) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *StreakMilestone) GetReachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReachedAt
+	}
+	return nil
+}
+
+var File_events_streak_proto protoreflect.FileDescriptor
+
+const file_events_streak_proto_rawDesc = "" +
+	"\n" +
+	"\x13events/streak.proto\x12\x12metacode.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xae\x01\n" +
+	"\x0eStreakExtended\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12;\n" +
+	"\vextended_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"extendedAt\"\xa2\x01\n" +
+	"\fStreakBroken\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\x12(\n" +
+	"\x10last_active_date\x18\x03 \x01(\tR\x0elastActiveDate\x127\n" +
+	"\tbroken_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bbrokenAt\"\xbc\x01\n" +
+	"\fStreakFrozen\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\x12\x1f\n" +
+	"\vfrozen_date\x18\x03 \x01(\tR\n" +
+	"frozenDate\x12!\n" +
+	"\ffreezes_left\x18\x04 \x01(\x05R\vfreezesLeft\x127\n" +
+	"\tfrozen_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bfrozenAt\"\x97\x01\n" +
+	"\x0fStreakMilestone\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tmilestone\x18\x02 \x01(\x05R\tmilestone\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x129\n" +
+	"\n" +
+	"reached_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\treachedAtB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_streak_proto_rawDescOnce sync.Once
+	file_events_streak_proto_rawDescData []byte
+)
+
+func file_events_streak_proto_rawDescGZIP() []byte {
+	file_events_streak_proto_rawDescOnce.Do(func() {
+		file_events_streak_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_streak_proto_rawDesc), len(file_events_streak_proto_rawDesc)))
+	})
+	return file_events_streak_proto_rawDescData
+}
+
+var file_events_streak_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_events_streak_proto_goTypes = []any{
+	(*StreakExtended)(nil),        // 0: metacode.events.v1.StreakExtended
+	(*StreakBroken)(nil),          // 1: metacode.events.v1.StreakBroken
+	(*StreakFrozen)(nil),          // 2: metacode.events.v1.StreakFrozen
+	(*StreakMilestone)(nil),       // 3: metacode.events.v1.StreakMilestone
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_events_streak_proto_depIdxs = []int32{
+	4, // 0: metacode.events.v1.StreakExtended.extended_at:type_name -> google.protobuf.Timestamp
+	4, // 1: metacode.events.v1.StreakBroken.broken_at:type_name -> google.protobuf.Timestamp
+	4, // 2: metacode.events.v1.StreakFrozen.frozen_at:type_name -> google.protobuf.Timestamp
+	4, // 3: metacode.events.v1.StreakMilestone.reached_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_events_streak_proto_init() }
+func file_events_streak_proto_init() {
+	if File_events_streak_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_streak_proto_rawDesc), len(file_events_streak_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_streak_proto_goTypes,
+		DependencyIndexes: file_events_streak_proto_depIdxs,
+		MessageInfos:      file_events_streak_proto_msgTypes,
+	}.Build()
+	File_events_streak_proto = out.File
+	file_events_streak_proto_goTypes = nil
+	file_events_streak_proto_depIdxs = nil
+}
@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: events/xp.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type XPAwarded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Amount        int32                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	TotalXp       int32                  `protobuf:"varint,5,opt,name=total_xp,json=totalXp,proto3" json:"total_xp,omitempty"`
+	Level         int32                  `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
+	AwardedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=awarded_at,json=awardedAt,proto3" json:"awarded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XPAwarded) Reset() {
+	*x = XPAwarded{}
+	mi := &file_events_xp_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XPAwarded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XPAwarded) ProtoMessage() {}
+
+func (x *XPAwarded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_xp_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XPAwarded.ProtoReflect.Descriptor instead.
+func (*XPAwarded) Descriptor() ([]byte, []int) {
+	return file_events_xp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *XPAwarded) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *XPAwarded) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *XPAwarded) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *XPAwarded) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *XPAwarded) GetTotalXp() int32 {
+	if x != nil {
+		return x.TotalXp
+	}
+	return 0
+}
+
+func (x *XPAwarded) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *XPAwarded) GetAwardedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AwardedAt
+	}
+	return nil
+}
+
+type LevelUp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	PreviousLevel int32                  `protobuf:"varint,3,opt,name=previous_level,json=previousLevel,proto3" json:"previous_level,omitempty"`
+	TotalXp       int32                  `protobuf:"varint,4,opt,name=total_xp,json=totalXp,proto3" json:"total_xp,omitempty"`
+	ReachedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=reached_at,json=reachedAt,proto3" json:"reached_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LevelUp) Reset() {
+	*x = LevelUp{}
+	mi := &file_events_xp_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LevelUp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelUp) ProtoMessage() {}
+
+func (x *LevelUp) ProtoReflect() protoreflect.Message {
+	mi := &file_events_xp_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LevelUp.ProtoReflect.Descriptor instead.
+func (*LevelUp) Descriptor() ([]byte, []int) {
+	return file_events_xp_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LevelUp) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LevelUp) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *LevelUp) GetPreviousLevel() int32 {
+	if x != nil {
+		return x.PreviousLevel
+	}
+	return 0
+}
+
+func (x *LevelUp) GetTotalXp() int32 {
+	if x != nil {
+		return x.TotalXp
+	}
+	return 0
+}
+
+func (x *LevelUp) GetReachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReachedAt
+	}
+	return nil
+}
+
+var File_events_xp_proto protoreflect.FileDescriptor
+
+const file_events_xp_proto_rawDesc = "" +
+	"\n" +
+	"\x0fevents/xp.proto\x12\x12metacode.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd8\x01\n" +
+	"\tXPAwarded\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x05R\x06amount\x12\x19\n" +
+	"\btotal_xp\x18\x05 \x01(\x05R\atotalXp\x12\x14\n" +
+	"\x05level\x18\x06 \x01(\x05R\x05level\x129\n" +
+	"\n" +
+	"awarded_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tawardedAt\"\xb5\x01\n" +
+	"\aLevelUp\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\x12%\n" +
+	"\x0eprevious_level\x18\x03 \x01(\x05R\rpreviousLevel\x12\x19\n" +
+	"\btotal_xp\x18\x04 \x01(\x05R\atotalXp\x129\n" +
+	"\n" +
+	"reached_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\treachedAtB@Z>github.com/metacode-dream-team/MetaCode/pkg/pb/events;eventspbb\x06proto3"
+
+var (
+	file_events_xp_proto_rawDescOnce sync.Once
+	file_events_xp_proto_rawDescData []byte
+)
+
+func file_events_xp_proto_rawDescGZIP() []byte {
+	file_events_xp_proto_rawDescOnce.Do(func() {
+		file_events_xp_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_xp_proto_rawDesc), len(file_events_xp_proto_rawDesc)))
+	})
+	return file_events_xp_proto_rawDescData
+}
+
+var file_events_xp_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_xp_proto_goTypes = []any{
+	(*XPAwarded)(nil),             // 0: metacode.events.v1.XPAwarded
+	(*LevelUp)(nil),               // 1: metacode.events.v1.LevelUp
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_events_xp_proto_depIdxs = []int32{
+	2, // 0: metacode.events.v1.XPAwarded.awarded_at:type_name -> google.protobuf.Timestamp
+	2, // 1: metacode.events.v1.LevelUp.reached_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_events_xp_proto_init() }
+func file_events_xp_proto_init() {
+	if File_events_xp_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_xp_proto_rawDesc), len(file_events_xp_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_xp_proto_goTypes,
+		DependencyIndexes: file_events_xp_proto_depIdxs,
+		MessageInfos:      file_events_xp_proto_msgTypes,
+	}.Build()
+	File_events_xp_proto = out.File
+	file_events_xp_proto_goTypes = nil
+	file_events_xp_proto_depIdxs = nil
+}
@@ -0,0 +1,284 @@
+package pii
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+	"github.com/metacode-dream-team/MetaCode/pkg/events/schema"
+)
+
+// prefix marks an encrypted field value: pii:v1:<base64url(nonce|ciphertext)>
+const prefix = "pii:v1:"
+
+const dataKeySize = 32
+
+// ErrKeyShredded is returned when an event holds fields encrypted with a data key that was
+// deleted by Forget. The personal data of that user can no longer be read
+var ErrKeyShredded = errors.New("data key of the user was shredded")
+
+type Config struct {
+	// MasterKey wraps the per-user data keys: 16, 24 or 32 bytes for AES-128/192/256
+	MasterKey []byte
+	// KeyCacheTTL bounds how long unwrapped data keys are kept in memory, and so how long
+	// other replicas may still decrypt after Forget. Default is 1m
+	KeyCacheTTL time.Duration
+}
+
+type cachedKey struct {
+	aead    cipher.AEAD
+	expires time.Time
+}
+
+// Cipher encrypts the fields tagged `pii:"true"` with an AES-GCM data key per user.
+// Data keys are wrapped with the master key before they reach the KeyStore
+type Cipher struct {
+	config    Config
+	store     KeyStore
+	master    cipher.AEAD
+	sensitive map[string][]string
+
+	mu   sync.Mutex
+	keys map[uuid.UUID]cachedKey
+}
+
+// NewCipher creates a Cipher for every registered event with sensitive fields
+func NewCipher(cfg Config, store KeyStore) (*Cipher, error) {
+	if store == nil {
+		return nil, errors.New("missing key store")
+	}
+	if cfg.KeyCacheTTL <= 0 {
+		cfg.KeyCacheTTL = time.Minute
+	}
+
+	master, err := newAEAD(cfg.MasterKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid master key: %w", err)
+	}
+
+	sensitive := map[string][]string{}
+	for _, d := range events.Registered() {
+		for _, f := range schema.Fields(d.Payload) {
+			if f.Sensitive {
+				sensitive[d.Type] = append(sensitive[d.Type], f.Name)
+			}
+		}
+	}
+
+	return &Cipher{
+		config:    cfg,
+		store:     store,
+		master:    master,
+		sensitive: sensitive,
+		keys:      map[uuid.UUID]cachedKey{},
+	}, nil
+}
+
+// Encrypt replaces the sensitive fields of an encoded payload with their ciphertext.
+// The data key of the payload's user is created on first use. Returns ErrKeyShredded
+// when a forgotten user's payload still carries personal data
+func (c *Cipher) Encrypt(ctx context.Context, eventType string, data json.RawMessage) (json.RawMessage, error) {
+	fields := c.sensitive[eventType]
+	if len(fields) == 0 {
+		return data, nil
+	}
+
+	userID, ok := events.UserIDOf(data)
+	if !ok {
+		return nil, fmt.Errorf("event %s has sensitive fields but no user_id", eventType)
+	}
+	var aead cipher.AEAD
+
+	return transform(data, fields, func(field, value string) (string, error) {
+		if value == "" || strings.HasPrefix(value, prefix) {
+			return value, nil
+		}
+		if aead == nil {
+			var err error
+			if aead, err = c.dataKey(ctx, userID, true); err != nil {
+				return "", err
+			}
+		}
+
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return "", fmt.Errorf("failed to generate nonce: %w", err)
+		}
+		sealed := aead.Seal(nonce, nonce, []byte(value), additionalData(userID, field))
+		return prefix + base64.RawURLEncoding.EncodeToString(sealed), nil
+	})
+}
+
+// Decrypt restores the sensitive fields of an encoded payload. Plaintext values written
+// before encryption was enabled are left as they are. Returns ErrKeyShredded once the
+// user was forgotten
+func (c *Cipher) Decrypt(ctx context.Context, eventType string, data json.RawMessage) (json.RawMessage, error) {
+	fields := c.sensitive[eventType]
+	if len(fields) == 0 {
+		return data, nil
+	}
+
+	userID, _ := events.UserIDOf(data)
+	var aead cipher.AEAD
+
+	return transform(data, fields, func(field, value string) (string, error) {
+		if !strings.HasPrefix(value, prefix) {
+			return value, nil
+		}
+
+		if aead == nil {
+			if userID == uuid.Nil {
+				return "", fmt.Errorf("event %s has encrypted fields but no user_id", eventType)
+			}
+			var err error
+			if aead, err = c.dataKey(ctx, userID, false); err != nil {
+				return "", err
+			}
+		}
+
+		sealed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(value, prefix))
+		if err != nil || len(sealed) < aead.NonceSize() {
+			return "", fmt.Errorf("malformed encrypted field %s", field)
+		}
+		nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+		plain, err := aead.Open(nil, nonce, ciphertext, additionalData(userID, field))
+		if err != nil {
+			return "", fmt.Errorf("failed to decrypt field %s: %w", field, err)
+		}
+		return string(plain), nil
+	})
+}
+
+// Forget deletes the data key of a user, which makes every encrypted field of the user
+// unreadable, including those in retained topics (crypto-shredding). The key is replaced
+// by a tombstone, so Encrypt refuses new personal data of the user instead of creating
+// a fresh key.
+//
+// Only this process drops its cached copy of the key right away. Other replicas keep
+// encrypting and decrypting with theirs until it expires, so shredding is complete only
+// KeyCacheTTL after Forget returns. Lower KeyCacheTTL where that window is too long
+func (c *Cipher) Forget(ctx context.Context, userID uuid.UUID) error {
+	if err := c.store.Delete(ctx, userID); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	delete(c.keys, userID)
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *Cipher) dataKey(ctx context.Context, userID uuid.UUID, create bool) (cipher.AEAD, error) {
+	c.mu.Lock()
+	cached, ok := c.keys[userID]
+	c.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.aead, nil
+	}
+
+	wrapped, err := c.store.Load(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if wrapped == nil {
+		if !create {
+			return nil, fmt.Errorf("user %s: %w", userID, ErrKeyShredded)
+		}
+		if wrapped, err = c.newDataKey(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := c.unwrap(userID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.keys[userID] = cachedKey{aead: aead, expires: time.Now().Add(c.config.KeyCacheTTL)}
+	c.mu.Unlock()
+	return aead, nil
+}
+
+func (c *Cipher) newDataKey(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	key := make([]byte, dataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	nonce := make([]byte, c.master.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	wrapped := c.master.Seal(nonce, nonce, key, userID[:])
+
+	return c.store.Create(ctx, userID, wrapped)
+}
+
+func (c *Cipher) unwrap(userID uuid.UUID, wrapped []byte) ([]byte, error) {
+	size := c.master.NonceSize()
+	if len(wrapped) < size {
+		return nil, errors.New("malformed data key")
+	}
+	key, err := c.master.Open(nil, wrapped[:size], wrapped[size:], userID[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key of user %s: %w", userID, err)
+	}
+	return key, nil
+}
+
+// transform rewrites the string fields of a JSON object, leaving the other fields untouched
+func transform(data json.RawMessage, fields []string, fn func(field, value string) (string, error)) (json.RawMessage, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("decode payload failed: %w", err)
+	}
+
+	for _, field := range fields {
+		raw, ok := object[field]
+		if !ok {
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, fmt.Errorf("sensitive field %s is not a string", field)
+		}
+
+		out, err := fn(field, value)
+		if err != nil {
+			return nil, err
+		}
+		if object[field], err = json.Marshal(out); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(object)
+}
+
+// additionalData binds a ciphertext to its user and field, so it cannot be moved elsewhere
+func additionalData(userID uuid.UUID, field string) []byte {
+	return append(userID[:], field...)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
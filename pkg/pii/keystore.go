@@ -0,0 +1,99 @@
+package pii
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/caching"
+	"github.com/redis/go-redis/v9"
+)
+
+// KeyStore persists the wrapped data key of every user. Keys never expire: deleting one
+// is what makes the encrypted fields of the user unreadable
+type KeyStore interface {
+	// Load returns nil when the user has no key yet and ErrKeyShredded after Delete
+	Load(ctx context.Context, userID uuid.UUID) ([]byte, error)
+	// Create stores the key unless the user already has one and returns the key in effect,
+	// so concurrent producers agree on a single key per user. Returns ErrKeyShredded
+	// after Delete, so a forgotten user never gets a fresh key
+	Create(ctx context.Context, userID uuid.UUID, wrapped []byte) ([]byte, error)
+	// Delete replaces the key with a tombstone that is kept for good
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
+
+// tombstone marks a deleted key. Wrapped keys are far longer, so it cannot collide
+const tombstone = "-"
+
+// RedisKeyStore keeps wrapped data keys in Redis under <prefix>:<user id>
+type RedisKeyStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// Ensure RedisKeyStore implements KeyStore
+var _ KeyStore = (*RedisKeyStore)(nil)
+
+// NewRedisKeyStore connects to Redis. The prefix defaults to "pii:data-key"
+func NewRedisKeyStore(cfg caching.RedisConfig, prefix string) (*RedisKeyStore, error) {
+	if prefix == "" {
+		prefix = "pii:data-key"
+	}
+
+	client, err := caching.NewRedisClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisKeyStore{client: client, prefix: prefix}, nil
+}
+
+func (s *RedisKeyStore) Load(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	key, err := s.client.Get(ctx, s.key(userID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load data key: %w", err)
+	}
+	if string(key) == tombstone {
+		return nil, fmt.Errorf("user %s: %w", userID, ErrKeyShredded)
+	}
+	return key, nil
+}
+
+func (s *RedisKeyStore) Create(ctx context.Context, userID uuid.UUID, wrapped []byte) ([]byte, error) {
+	created, err := s.client.SetNX(ctx, s.key(userID), wrapped, 0).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to store data key: %w", err)
+	}
+	if created {
+		return wrapped, nil
+	}
+
+	existing, err := s.Load(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, errors.New("data key deleted while being created")
+	}
+	return existing, nil
+}
+
+func (s *RedisKeyStore) Delete(ctx context.Context, userID uuid.UUID) error {
+	if err := s.client.Set(ctx, s.key(userID), tombstone, 0).Err(); err != nil {
+		return fmt.Errorf("failed to delete data key: %w", err)
+	}
+	return nil
+}
+
+// Close gracefully closes Redis connection
+func (s *RedisKeyStore) Close() error {
+	return s.client.Close()
+}
+
+func (s *RedisKeyStore) key(userID uuid.UUID) string {
+	return s.prefix + ":" + userID.String()
+}
@@ -0,0 +1,86 @@
+package projection
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+)
+
+// ErrInvalidEvent marks events a projection cannot fold, such as payloads that fail to
+// decode or validate. The runner skips them instead of retrying
+var ErrInvalidEvent = errors.New("invalid event")
+
+// Handler folds the payload of one event into the projection state
+type Handler func(ctx context.Context, store Store, data json.RawMessage) error
+
+// Projection is a read model built by folding events into a Store
+type Projection struct {
+	name     string
+	handlers map[string]Handler
+}
+
+// New creates an empty projection. The name identifies its state and checkpoints
+func New(name string) *Projection {
+	return &Projection{name: name, handlers: map[string]Handler{}}
+}
+
+func (p *Projection) Name() string {
+	return p.name
+}
+
+// Handle registers the handler of an event type
+func (p *Projection) Handle(eventType string, handler Handler) *Projection {
+	p.handlers[eventType] = handler
+	return p
+}
+
+// Handles lists the event types the projection folds, sorted
+func (p *Projection) Handles() []string {
+	types := make([]string, 0, len(p.handlers))
+	for t := range p.handlers {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Topics lists the topics carrying the handled event types, as registered in events
+func (p *Projection) Topics() ([]string, error) {
+	seen := map[string]bool{}
+	var topics []string
+	for _, t := range p.Handles() {
+		d, ok := events.Lookup(t)
+		if !ok {
+			return nil, fmt.Errorf("projection %s handles unregistered event type %s", p.name, t)
+		}
+		if !seen[d.Topic] {
+			seen[d.Topic] = true
+			topics = append(topics, d.Topic)
+		}
+	}
+	return topics, nil
+}
+
+// Apply folds one event. Events of types the projection does not handle are ignored
+func (p *Projection) Apply(ctx context.Context, store Store, event events.Event) error {
+	handler, ok := p.handlers[event.Type]
+	if !ok {
+		return nil
+	}
+	return handler(ctx, store, event.Data)
+}
+
+// On registers a typed handler: the payload is decoded into T first
+func On[T any](p *Projection, eventType string, fold func(ctx context.Context, store Store, event T) error) {
+	p.Handle(eventType, func(ctx context.Context, store Store, data json.RawMessage) error {
+		var payload T
+		if err := json.Unmarshal(data, &payload); err != nil {
+			return fmt.Errorf("%w: decode %s payload: %v", ErrInvalidEvent, eventType, err)
+		}
+		return fold(ctx, store, payload)
+	})
+}
@@ -0,0 +1,337 @@
+package projection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+	"github.com/metacode-dream-team/MetaCode/pkg/messaging"
+	"github.com/metacode-dream-team/MetaCode/pkg/pii"
+)
+
+type RunnerConfig struct {
+	BootstrapServers string
+	// Topics defaults to the topics of the event types the projection handles
+	Topics []string
+	// Cipher decrypts sensitive fields before they are folded
+	Cipher        *pii.Cipher
+	EnableLogging bool
+	LogOutput     io.Writer
+	ErrOutput     io.Writer
+
+	// ReadTimeout defines how long the consumer waits for a message (ms)
+	// Default is 1000ms to prevent CPU busy loops
+	ReadTimeout int
+	// ErrorBackoff defines pause duration after a read error or a failed fold. Default is 2s
+	ErrorBackoff time.Duration
+	// MetadataTimeout bounds broker metadata and watermark queries. Default is 10s
+	MetadataTimeout time.Duration
+	// RejectInvalid skips payloads of registered event types that fail validation instead
+	// of folding them. Off by default, since retained events written before a validation
+	// rule existed need not satisfy it
+	RejectInvalid bool
+}
+
+// Runner feeds a projection from Kafka. Partitions are assigned manually and resumed from
+// the store checkpoints rather than consumer group offsets, so run a single Runner per
+// projection and store.
+type Runner struct {
+	config     RunnerConfig
+	projection *Projection
+	store      Store
+	consumer   *kafka.Consumer
+	logger     *log.Logger
+	errLogger  *log.Logger
+}
+
+// Ensure Runner implements Consumer
+var _ messaging.Consumer = (*Runner)(nil)
+
+// NewRunner creates a Runner folding the topics into the store
+func NewRunner(cfg RunnerConfig, projection *Projection, store Store) (*Runner, error) {
+	if projection == nil || store == nil {
+		return nil, errors.New("missing projection or store")
+	}
+	if len(cfg.Topics) == 0 {
+		topics, err := projection.Topics()
+		if err != nil {
+			return nil, err
+		}
+		cfg.Topics = topics
+	}
+	if cfg.LogOutput == nil {
+		cfg.LogOutput = os.Stdout
+	}
+	if cfg.ErrOutput == nil {
+		cfg.ErrOutput = os.Stderr
+	}
+	if cfg.ReadTimeout <= 0 {
+		cfg.ReadTimeout = 1000
+	}
+	if cfg.ErrorBackoff <= 0 {
+		cfg.ErrorBackoff = 2 * time.Second
+	}
+	if cfg.MetadataTimeout <= 0 {
+		cfg.MetadataTimeout = 10 * time.Second
+	}
+
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  cfg.BootstrapServers,
+		"group.id":           "projection-" + projection.Name(),
+		"enable.auto.commit": false,
+		"auto.offset.reset":  "earliest",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create projection consumer: %w", err)
+	}
+
+	return &Runner{
+		config:     cfg,
+		projection: projection,
+		store:      store,
+		consumer:   consumer,
+		logger:     log.New(cfg.LogOutput, "[PROJECTION] INFO: ", log.LstdFlags),
+		errLogger:  log.New(cfg.ErrOutput, "[PROJECTION] ERROR: ", log.LstdFlags),
+	}, nil
+}
+
+// Start folds events from the last checkpoint until the context is cancelled
+func (r *Runner) Start(ctx context.Context) {
+	if _, err := r.assign(ctx); err != nil {
+		r.logErr("Failed to assign partitions: %v", err)
+		return
+	}
+
+	r.logInfo("Projection %s started. Topics: %v", r.projection.Name(), r.config.Topics)
+
+	for ctx.Err() == nil {
+		msg, ok := r.read(ctx)
+		if ok {
+			r.process(ctx, msg)
+		}
+	}
+	r.logInfo("Context cancelled, stopping projection %s...", r.projection.Name())
+}
+
+// CatchUp folds events from the last checkpoint up to the end of every partition as it
+// was when CatchUp was called, then returns. A partition counts as caught up once the
+// consumer position reaches that end, even if the offsets before it were not messages
+func (r *Runner) CatchUp(ctx context.Context) error {
+	next, err := r.assign(ctx)
+	if err != nil {
+		return err
+	}
+
+	end := map[Position]int64{}
+	for pos := range next {
+		low, high, err := r.consumer.QueryWatermarkOffsets(pos.Topic, pos.Partition, int(r.config.MetadataTimeout.Milliseconds()))
+		if err != nil {
+			return fmt.Errorf("failed to query watermarks of %s[%d]: %w", pos.Topic, pos.Partition, err)
+		}
+		// Partitions without checkpoint, or whose checkpoint fell out of retention, start at the low watermark
+		if next[pos] < low {
+			next[pos] = low
+		}
+		end[pos] = high
+	}
+
+	for {
+		behind := false
+		for pos, high := range end {
+			if next[pos] < high {
+				behind = true
+				break
+			}
+		}
+		if !behind {
+			r.logInfo("Projection %s caught up", r.projection.Name())
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		msg, ok := r.read(ctx)
+		if !ok {
+			// The last offsets of a partition may never be delivered: transaction markers,
+			// aborted records and compacted gaps. The consumer position moves past them
+			if err := r.advance(next); err != nil {
+				return err
+			}
+			continue
+		}
+		r.process(ctx, msg)
+
+		pos := Position{Topic: *msg.TopicPartition.Topic, Partition: msg.TopicPartition.Partition}
+		next[pos] = int64(msg.TopicPartition.Offset) + 1
+	}
+}
+
+// advance moves next forward to the consumer position of every partition
+func (r *Runner) advance(next map[Position]int64) error {
+	partitions := make([]kafka.TopicPartition, 0, len(next))
+	for pos := range next {
+		topic := pos.Topic
+		partitions = append(partitions, kafka.TopicPartition{Topic: &topic, Partition: pos.Partition})
+	}
+
+	positions, err := r.consumer.Position(partitions)
+	if err != nil {
+		return fmt.Errorf("failed to get consumer position: %w", err)
+	}
+	for _, tp := range positions {
+		pos := Position{Topic: *tp.Topic, Partition: tp.Partition}
+		// Partitions nothing was fetched from yet report a negative logical offset
+		if offset := int64(tp.Offset); offset > next[pos] {
+			next[pos] = offset
+		}
+	}
+	return nil
+}
+
+// Rebuild drops the projection state and folds every topic again from the beginning
+func (r *Runner) Rebuild(ctx context.Context) error {
+	r.logInfo("Rebuilding projection %s...", r.projection.Name())
+	if err := r.store.Reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset projection store: %w", err)
+	}
+	return r.CatchUp(ctx)
+}
+
+// assign starts every partition of the topics right after its checkpoint and returns the
+// next offset per partition, with kafka.OffsetBeginning for partitions without a checkpoint
+func (r *Runner) assign(ctx context.Context) (map[Position]int64, error) {
+	checkpoints, err := r.store.Checkpoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load checkpoints: %w", err)
+	}
+
+	next := map[Position]int64{}
+	var partitions []kafka.TopicPartition
+	for _, topic := range r.config.Topics {
+		topic := topic
+		metadata, err := r.consumer.GetMetadata(&topic, false, int(r.config.MetadataTimeout.Milliseconds()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metadata of %s: %w", topic, err)
+		}
+
+		for _, p := range metadata.Topics[topic].Partitions {
+			pos := Position{Topic: topic, Partition: p.ID}
+			offset := kafka.OffsetBeginning
+			if last, ok := checkpoints[pos]; ok {
+				offset = kafka.Offset(last + 1)
+			}
+			next[pos] = int64(offset)
+			partitions = append(partitions, kafka.TopicPartition{Topic: &topic, Partition: p.ID, Offset: offset})
+		}
+	}
+
+	if err := r.consumer.Assign(partitions); err != nil {
+		return nil, fmt.Errorf("failed to assign partitions: %w", err)
+	}
+	return next, nil
+}
+
+func (r *Runner) read(ctx context.Context) (*kafka.Message, bool) {
+	msg, err := r.consumer.ReadMessage(time.Duration(r.config.ReadTimeout) * time.Millisecond)
+	if err == nil {
+		return msg, true
+	}
+
+	var kafkaErr kafka.Error
+	if !errors.As(err, &kafkaErr) || kafkaErr.Code() != kafka.ErrTimedOut {
+		r.logErr("Message read error: %v. Retrying in %v...", err, r.config.ErrorBackoff)
+		r.backoff(ctx)
+	}
+	return nil, false
+}
+
+// process folds one message and moves the checkpoint past it. Failed folds are retried
+// until they succeed, since skipping an event would leave the read model inconsistent
+func (r *Runner) process(ctx context.Context, msg *kafka.Message) {
+	pos := Position{Topic: *msg.TopicPartition.Topic, Partition: msg.TopicPartition.Partition}
+
+	event, err := messaging.DecodeMessage(msg)
+	if err != nil {
+		r.logErr("Failed to decode event: %v | Raw: %s", err, string(msg.Value))
+	} else {
+		for {
+			err := r.apply(ctx, event)
+			if err == nil {
+				break
+			}
+			if errors.Is(err, ErrInvalidEvent) || errors.Is(err, pii.ErrKeyShredded) {
+				r.logErr("Skipping event %s: %v", event.Type, err)
+				break
+			}
+
+			r.logErr("Failed to fold event %s: %v. Retrying in %v...", event.Type, err, r.config.ErrorBackoff)
+			if !r.backoff(ctx) {
+				return
+			}
+		}
+	}
+
+	for {
+		err := r.store.SetCheckpoint(ctx, pos, int64(msg.TopicPartition.Offset))
+		if err == nil {
+			return
+		}
+		r.logErr("Failed to save checkpoint: %v. Retrying in %v...", err, r.config.ErrorBackoff)
+		if !r.backoff(ctx) {
+			return
+		}
+	}
+}
+
+// apply decrypts and folds handled events; others are skipped without touching the key store
+func (r *Runner) apply(ctx context.Context, event events.Event) error {
+	if _, ok := r.projection.handlers[event.Type]; !ok {
+		return nil
+	}
+	if r.config.Cipher != nil {
+		data, err := r.config.Cipher.Decrypt(ctx, event.Type, event.Data)
+		if err != nil {
+			return err
+		}
+		event.Data = data
+	}
+	if _, ok := events.Lookup(event.Type); ok && r.config.RejectInvalid {
+		if _, err := events.Decode(event.Type, event.Data); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+		}
+	}
+	return r.projection.Apply(ctx, r.store, event)
+}
+
+func (r *Runner) backoff(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(r.config.ErrorBackoff):
+		return true
+	}
+}
+
+func (r *Runner) logInfo(format string, v ...interface{}) {
+	if r.config.EnableLogging {
+		r.logger.Printf(format, v...)
+	}
+}
+
+func (r *Runner) logErr(format string, v ...interface{}) {
+	if r.config.EnableLogging {
+		r.errLogger.Printf(format, v...)
+	}
+}
+
+func (r *Runner) Close() {
+	r.logInfo("Closing projection %s...", r.projection.Name())
+	_ = r.consumer.Close()
+}
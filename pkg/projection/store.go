@@ -0,0 +1,130 @@
+package projection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Position identifies a topic partition
+type Position struct {
+	Topic     string
+	Partition int32
+}
+
+// Store holds the state of one projection together with its checkpoints. Implementations
+// that save state and checkpoint in one transaction get exactly-once folding; others
+// must expect an event to be folded again after a restart
+type Store interface {
+	// Get decodes the state stored under key into state. Returns false when there is none
+	Get(ctx context.Context, key string, state interface{}) (bool, error)
+	Put(ctx context.Context, key string, state interface{}) error
+	Delete(ctx context.Context, key string) error
+
+	// Checkpoints returns the offset of the last processed message per partition
+	Checkpoints(ctx context.Context) (map[Position]int64, error)
+	SetCheckpoint(ctx context.Context, pos Position, offset int64) error
+
+	// Reset drops all state and checkpoints, e.g. before a rebuild
+	Reset(ctx context.Context) error
+}
+
+// Update loads the state under key (zero when missing), lets fn modify it and stores it
+func Update[S any](ctx context.Context, store Store, key string, fn func(state *S) error) error {
+	var state S
+	if _, err := store.Get(ctx, key, &state); err != nil {
+		return err
+	}
+	if err := fn(&state); err != nil {
+		return err
+	}
+	return store.Put(ctx, key, state)
+}
+
+// MemoryStore keeps projection state in memory, encoded as JSON like a persistent store
+// would. It is meant for tests and for projections that are rebuilt on every start
+type MemoryStore struct {
+	mu          sync.RWMutex
+	states      map[string][]byte
+	checkpoints map[Position]int64
+}
+
+// Ensure MemoryStore implements Store
+var _ Store = (*MemoryStore)(nil)
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		states:      map[string][]byte{},
+		checkpoints: map[Position]int64{},
+	}
+}
+
+func (s *MemoryStore) Get(_ context.Context, key string, state interface{}) (bool, error) {
+	s.mu.RLock()
+	data, ok := s.states[key]
+	s.mu.RUnlock()
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return false, fmt.Errorf("failed to decode state %s: %w", key, err)
+	}
+	return true, nil
+}
+
+func (s *MemoryStore) Put(_ context.Context, key string, state interface{}) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode state %s: %w", key, err)
+	}
+	s.mu.Lock()
+	s.states[key] = data
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	delete(s.states, key)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryStore) Checkpoints(context.Context) (map[Position]int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := make(map[Position]int64, len(s.checkpoints))
+	for pos, offset := range s.checkpoints {
+		out[pos] = offset
+	}
+	return out, nil
+}
+
+func (s *MemoryStore) SetCheckpoint(_ context.Context, pos Position, offset int64) error {
+	s.mu.Lock()
+	s.checkpoints[pos] = offset
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryStore) Reset(context.Context) error {
+	s.mu.Lock()
+	s.states = map[string][]byte{}
+	s.checkpoints = map[Position]int64{}
+	s.mu.Unlock()
+	return nil
+}
+
+// Keys lists the keys that hold state
+func (s *MemoryStore) Keys() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]string, 0, len(s.states))
+	for k := range s.states {
+		keys = append(keys, k)
+	}
+	return keys
+}
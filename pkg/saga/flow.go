@@ -0,0 +1,191 @@
+package saga
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+)
+
+const (
+	ReasonTimeout = "timeout"
+
+	ReasonUnboundVerificationTimeout = "verification_timeout"
+	ReasonUnboundVerificationFailed  = "verification_failed"
+)
+
+// Flow describes the bind → verify → succeed/fail lifecycle of one integration:
+// which events drive it and how to build the events it emits
+type Flow struct {
+	Source  events.Source
+	Timeout time.Duration
+
+	BoundType     string
+	UnboundType   string
+	SucceededType string
+	FailedType    string
+
+	// DecodeBound extracts the user, the external username and whether the account
+	// is already verified from a bound payload
+	DecodeBound func(data json.RawMessage) (userID uuid.UUID, username string, verified bool, err error)
+	// DecodeFailed extracts the user and the failure reason from a failed payload
+	DecodeFailed func(data json.RawMessage) (userID uuid.UUID, reason string, err error)
+
+	NewSucceeded func(state *State, at time.Time) interface{}
+	NewFailed    func(state *State, reason, errorCode string, at time.Time) interface{}
+	NewUnbound   func(state *State, reason string, at time.Time) interface{}
+}
+
+// userPayload matches the user_id field shared by every flow payload
+type userPayload struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func decodeUser(data json.RawMessage) (uuid.UUID, error) {
+	var p userPayload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return uuid.Nil, err
+	}
+	return p.UserID, nil
+}
+
+// LeetCodeFlow is the verification flow of LeetCode accounts
+func LeetCodeFlow(timeout time.Duration) Flow {
+	return Flow{
+		Source:        events.SourceLeetcode,
+		Timeout:       timeout,
+		BoundType:     events.EventTypeLeetCodeAccountBound,
+		UnboundType:   events.EventTypeLeetCodeAccountUnbound,
+		SucceededType: events.EventTypeLeetCodeVerificationSucceeded,
+		FailedType:    events.EventTypeLeetCodeVerificationFailed,
+		DecodeBound: func(data json.RawMessage) (uuid.UUID, string, bool, error) {
+			var e events.LeetCodeAccountBound
+			if err := json.Unmarshal(data, &e); err != nil {
+				return uuid.Nil, "", false, err
+			}
+			return e.UserID, e.LeetCodeUsername, e.Verified, nil
+		},
+		DecodeFailed: func(data json.RawMessage) (uuid.UUID, string, error) {
+			var e events.LeetCodeVerificationFailed
+			if err := json.Unmarshal(data, &e); err != nil {
+				return uuid.Nil, "", err
+			}
+			return e.UserID, e.Reason, nil
+		},
+		NewSucceeded: func(s *State, at time.Time) interface{} {
+			return events.LeetCodeVerificationSucceeded{UserID: s.UserID, LeetCodeUsername: s.Username, VerifiedAt: at}
+		},
+		NewFailed: func(s *State, reason, errorCode string, at time.Time) interface{} {
+			return events.LeetCodeVerificationFailed{UserID: s.UserID, LeetCodeUsername: s.Username, FailedAt: at, Reason: reason, ErrorCode: errorCode}
+		},
+		NewUnbound: func(s *State, reason string, at time.Time) interface{} {
+			return events.LeetCodeAccountUnbound{UserID: s.UserID, LeetCodeUsername: s.Username, UnboundAt: at, Reason: reason}
+		},
+	}
+}
+
+// MonkeytypeFlow is the verification flow of Monkeytype accounts
+func MonkeytypeFlow(timeout time.Duration) Flow {
+	return Flow{
+		Source:        events.SourceMonkeytype,
+		Timeout:       timeout,
+		BoundType:     events.EventTypeMonkeytypeAccountBound,
+		UnboundType:   events.EventTypeMonkeytypeAccountUnbound,
+		SucceededType: events.EventTypeMonkeytypeVerificationSucceeded,
+		FailedType:    events.EventTypeMonkeytypeVerificationFailed,
+		DecodeBound: func(data json.RawMessage) (uuid.UUID, string, bool, error) {
+			var e events.MonkeytypeAccountBound
+			if err := json.Unmarshal(data, &e); err != nil {
+				return uuid.Nil, "", false, err
+			}
+			return e.UserID, e.MonkeytypeUsername, e.Verified, nil
+		},
+		DecodeFailed: func(data json.RawMessage) (uuid.UUID, string, error) {
+			var e events.MonkeytypeVerificationFailed
+			if err := json.Unmarshal(data, &e); err != nil {
+				return uuid.Nil, "", err
+			}
+			return e.UserID, e.Reason, nil
+		},
+		NewSucceeded: func(s *State, at time.Time) interface{} {
+			return events.MonkeytypeVerificationSucceeded{UserID: s.UserID, MonkeytypeUsername: s.Username, VerifiedAt: at}
+		},
+		NewFailed: func(s *State, reason, errorCode string, at time.Time) interface{} {
+			return events.MonkeytypeVerificationFailed{UserID: s.UserID, MonkeytypeUsername: s.Username, FailedAt: at, Reason: reason, ErrorCode: errorCode}
+		},
+		NewUnbound: func(s *State, reason string, at time.Time) interface{} {
+			return events.MonkeytypeAccountUnbound{UserID: s.UserID, MonkeytypeUsername: s.Username, UnboundAt: at, Reason: reason}
+		},
+	}
+}
+
+// CodeforcesFlow is the verification flow of Codeforces accounts
+func CodeforcesFlow(timeout time.Duration) Flow {
+	return Flow{
+		Source:        events.SourceCodeforces,
+		Timeout:       timeout,
+		BoundType:     events.EventTypeCodeforcesAccountBound,
+		UnboundType:   events.EventTypeCodeforcesAccountUnbound,
+		SucceededType: events.EventTypeCodeforcesVerificationSucceeded,
+		FailedType:    events.EventTypeCodeforcesVerificationFailed,
+		DecodeBound: func(data json.RawMessage) (uuid.UUID, string, bool, error) {
+			var e events.CodeforcesAccountBound
+			if err := json.Unmarshal(data, &e); err != nil {
+				return uuid.Nil, "", false, err
+			}
+			return e.UserID, e.CodeforcesHandle, e.Verified, nil
+		},
+		DecodeFailed: func(data json.RawMessage) (uuid.UUID, string, error) {
+			var e events.CodeforcesVerificationFailed
+			if err := json.Unmarshal(data, &e); err != nil {
+				return uuid.Nil, "", err
+			}
+			return e.UserID, e.Reason, nil
+		},
+		NewSucceeded: func(s *State, at time.Time) interface{} {
+			return events.CodeforcesVerificationSucceeded{UserID: s.UserID, CodeforcesHandle: s.Username, VerifiedAt: at}
+		},
+		NewFailed: func(s *State, reason, errorCode string, at time.Time) interface{} {
+			return events.CodeforcesVerificationFailed{UserID: s.UserID, CodeforcesHandle: s.Username, FailedAt: at, Reason: reason, ErrorCode: errorCode}
+		},
+		NewUnbound: func(s *State, reason string, at time.Time) interface{} {
+			return events.CodeforcesAccountUnbound{UserID: s.UserID, CodeforcesHandle: s.Username, UnboundAt: at, Reason: reason}
+		},
+	}
+}
+
+// CodewarsFlow is the verification flow of Codewars accounts
+func CodewarsFlow(timeout time.Duration) Flow {
+	return Flow{
+		Source:        events.SourceCodewars,
+		Timeout:       timeout,
+		BoundType:     events.EventTypeCodewarsAccountBound,
+		UnboundType:   events.EventTypeCodewarsAccountUnbound,
+		SucceededType: events.EventTypeCodewarsVerificationSucceeded,
+		FailedType:    events.EventTypeCodewarsVerificationFailed,
+		DecodeBound: func(data json.RawMessage) (uuid.UUID, string, bool, error) {
+			var e events.CodewarsAccountBound
+			if err := json.Unmarshal(data, &e); err != nil {
+				return uuid.Nil, "", false, err
+			}
+			return e.UserID, e.CodewarsUsername, e.Verified, nil
+		},
+		DecodeFailed: func(data json.RawMessage) (uuid.UUID, string, error) {
+			var e events.CodewarsVerificationFailed
+			if err := json.Unmarshal(data, &e); err != nil {
+				return uuid.Nil, "", err
+			}
+			return e.UserID, e.Reason, nil
+		},
+		NewSucceeded: func(s *State, at time.Time) interface{} {
+			return events.CodewarsVerificationSucceeded{UserID: s.UserID, CodewarsUsername: s.Username, VerifiedAt: at}
+		},
+		NewFailed: func(s *State, reason, errorCode string, at time.Time) interface{} {
+			return events.CodewarsVerificationFailed{UserID: s.UserID, CodewarsUsername: s.Username, FailedAt: at, Reason: reason, ErrorCode: errorCode}
+		},
+		NewUnbound: func(s *State, reason string, at time.Time) interface{} {
+			return events.CodewarsAccountUnbound{UserID: s.UserID, CodewarsUsername: s.Username, UnboundAt: at, Reason: reason}
+		},
+	}
+}
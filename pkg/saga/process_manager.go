@@ -0,0 +1,289 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/messaging"
+	"github.com/metacode-dream-team/MetaCode/pkg/pii"
+)
+
+var (
+	ErrFlowNotFound   = errors.New("verification flow not found")
+	ErrFlowNotPending = errors.New("verification flow is not pending")
+	ErrFlowExpired    = errors.New("verification flow has expired")
+)
+
+// ProcessManager drives the verification lifecycle of a single Flow. It reacts to the
+// flow's events, keeps per-user state in a Store and uses a Scheduler to fire the
+// timeout failure when a bound account is not verified in time.
+//
+// A transition is saved together with the events it emits (State.Outbox) before they are
+// produced. When producing fails, calling Succeed or Fail again, or the redelivery of the
+// failed event, emits the rest. An event may therefore be emitted more than once.
+type ProcessManager struct {
+	flow      Flow
+	store     Store
+	producer  messaging.Producer
+	scheduler messaging.Scheduler
+}
+
+// NewProcessManager creates a new ProcessManager for the given flow
+func NewProcessManager(flow Flow, store Store, producer messaging.Producer, scheduler messaging.Scheduler) (*ProcessManager, error) {
+	if store == nil || producer == nil || scheduler == nil {
+		return nil, errors.New("missing store, producer or scheduler")
+	}
+	if flow.Timeout <= 0 {
+		return nil, errors.New("flow timeout must be positive")
+	}
+
+	return &ProcessManager{
+		flow:      flow,
+		store:     store,
+		producer:  producer,
+		scheduler: scheduler,
+	}, nil
+}
+
+// Register subscribes the manager to the flow's events
+func (m *ProcessManager) Register(c *messaging.KafkaConsumer) {
+	c.RegisterHandler(m.flow.BoundType, m.handleBound)
+	c.RegisterHandler(m.flow.SucceededType, m.handleSucceeded)
+	c.RegisterHandler(m.flow.FailedType, m.handleFailed)
+	c.RegisterHandler(m.flow.UnboundType, m.handleUnbound)
+}
+
+// State returns the current flow state of the user, or nil if there is none
+func (m *ProcessManager) State(ctx context.Context, userID uuid.UUID) (*State, error) {
+	return m.store.Load(ctx, m.flow.Source, userID)
+}
+
+// Succeed completes a pending verification and emits the succeeded event. It resumes a
+// success whose event was not emitted yet
+func (m *ProcessManager) Succeed(ctx context.Context, userID uuid.UUID) error {
+	state, err := m.pending(ctx, userID, StatusVerified)
+	if err != nil || state.Status == StatusVerified {
+		return err
+	}
+
+	now := time.Now()
+	if now.After(state.Deadline) {
+		if err := m.fail(ctx, state, ReasonTimeout, "", now); err != nil {
+			return err
+		}
+		return ErrFlowExpired
+	}
+
+	state.Status = StatusVerified
+	state.UpdatedAt = now
+	state.Outbox = []string{m.flow.SucceededType}
+	if err := m.store.Save(ctx, state); err != nil {
+		return err
+	}
+
+	if _, err := m.scheduler.Cancel(ctx, m.timeoutKey(userID)); err != nil {
+		return err
+	}
+	return m.emit(ctx, state)
+}
+
+// Fail aborts a pending verification, emitting the failed event followed by the unbound
+// event. It resumes a failure whose events were not all emitted yet
+func (m *ProcessManager) Fail(ctx context.Context, userID uuid.UUID, reason, errorCode string) error {
+	state, err := m.pending(ctx, userID, StatusFailed)
+	if err != nil || state.Status == StatusFailed {
+		return err
+	}
+
+	return m.fail(ctx, state, reason, errorCode, time.Now())
+}
+
+func (m *ProcessManager) fail(ctx context.Context, state *State, reason, errorCode string, at time.Time) error {
+	state.Status = StatusFailed
+	state.Reason = reason
+	state.ErrorCode = errorCode
+	state.UpdatedAt = at
+	state.Outbox = []string{m.flow.FailedType, m.flow.UnboundType}
+	if err := m.store.Save(ctx, state); err != nil {
+		return err
+	}
+
+	if _, err := m.scheduler.Cancel(ctx, m.timeoutKey(state.UserID)); err != nil {
+		return err
+	}
+	return m.emit(ctx, state)
+}
+
+// pending loads a pending flow. A flow already in the resumed status that still has
+// events to emit is returned too, after emitting them
+func (m *ProcessManager) pending(ctx context.Context, userID uuid.UUID, resumed Status) (*State, error) {
+	state, err := m.store.Load(ctx, m.flow.Source, userID)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, ErrFlowNotFound
+	}
+	if state.Status == resumed && len(state.Outbox) > 0 {
+		return state, m.emit(ctx, state)
+	}
+	if state.Status != StatusPending {
+		return nil, ErrFlowNotPending
+	}
+	return state, nil
+}
+
+func (m *ProcessManager) handleBound(data json.RawMessage) error {
+	ctx := context.Background()
+
+	userID, username, verified, err := m.flow.DecodeBound(data)
+	if err != nil {
+		return fmt.Errorf("decode %s: %w", m.flow.BoundType, err)
+	}
+
+	now := time.Now()
+	state := &State{
+		UserID:    userID,
+		Source:    m.flow.Source,
+		Username:  username,
+		Status:    StatusPending,
+		BoundAt:   now,
+		Deadline:  now.Add(m.flow.Timeout),
+		UpdatedAt: now,
+	}
+
+	if verified {
+		state.Status = StatusVerified
+		return m.store.Save(ctx, state)
+	}
+
+	if err := m.store.Save(ctx, state); err != nil {
+		return err
+	}
+
+	timeout := m.flow.NewFailed(state, ReasonTimeout, "", state.Deadline)
+	return m.scheduler.Schedule(ctx, m.timeoutKey(userID), state.Deadline, m.flow.FailedType, timeout)
+}
+
+// handleSucceeded records verifications reported by other services
+func (m *ProcessManager) handleSucceeded(data json.RawMessage) error {
+	ctx := context.Background()
+
+	userID, err := decodeUser(data)
+	if err != nil {
+		return fmt.Errorf("decode %s: %w", m.flow.SucceededType, err)
+	}
+
+	state, err := m.store.Load(ctx, m.flow.Source, userID)
+	if err != nil || state == nil || state.Status != StatusPending {
+		return err
+	}
+
+	if _, err := m.scheduler.Cancel(ctx, m.timeoutKey(userID)); err != nil {
+		return err
+	}
+
+	state.Status = StatusVerified
+	state.UpdatedAt = time.Now()
+	return m.store.Save(ctx, state)
+}
+
+// handleFailed records failures reported by other services, including the scheduled
+// timeout, and releases the account binding
+func (m *ProcessManager) handleFailed(data json.RawMessage) error {
+	ctx := context.Background()
+
+	userID, reason, err := m.flow.DecodeFailed(data)
+	if err != nil {
+		return fmt.Errorf("decode %s: %w", m.flow.FailedType, err)
+	}
+
+	state, err := m.store.Load(ctx, m.flow.Source, userID)
+	if err != nil || state == nil {
+		return err
+	}
+	if state.Status == StatusFailed && len(state.Outbox) > 0 {
+		return m.emit(ctx, state)
+	}
+	if state.Status != StatusPending {
+		return nil
+	}
+
+	// The failed event itself was emitted by whoever reported the failure
+	state.Status = StatusFailed
+	state.Reason = reason
+	state.UpdatedAt = time.Now()
+	state.Outbox = []string{m.flow.UnboundType}
+	if err := m.store.Save(ctx, state); err != nil {
+		return err
+	}
+
+	if _, err := m.scheduler.Cancel(ctx, m.timeoutKey(userID)); err != nil {
+		return err
+	}
+	return m.emit(ctx, state)
+}
+
+// handleUnbound ends the flow, whether the unbind was manual or followed a failure
+func (m *ProcessManager) handleUnbound(data json.RawMessage) error {
+	ctx := context.Background()
+
+	userID, err := decodeUser(data)
+	if err != nil {
+		return fmt.Errorf("decode %s: %w", m.flow.UnboundType, err)
+	}
+
+	if _, err := m.scheduler.Cancel(ctx, m.timeoutKey(userID)); err != nil {
+		return err
+	}
+
+	return m.store.Delete(ctx, m.flow.Source, userID)
+}
+
+// emit produces the outbox of the state in order, saving the state after every event so
+// a retry continues with the next one
+func (m *ProcessManager) emit(ctx context.Context, state *State) error {
+	for len(state.Outbox) > 0 {
+		eventType := state.Outbox[0]
+		// Events of a forgotten user can no longer be written, so they are dropped rather
+		// than retried forever
+		err := m.producer.Produce(ctx, eventType, m.event(state, eventType))
+		if err != nil && !errors.Is(err, pii.ErrKeyShredded) {
+			return err
+		}
+
+		state.Outbox = state.Outbox[1:]
+		// The unbound event ends the flow, like handleUnbound does
+		if eventType == m.flow.UnboundType && len(state.Outbox) == 0 {
+			return m.store.Delete(ctx, state.Source, state.UserID)
+		}
+		if err := m.store.Save(ctx, state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// event builds the payload of an outbox entry from the state that decided it
+func (m *ProcessManager) event(state *State, eventType string) interface{} {
+	switch eventType {
+	case m.flow.SucceededType:
+		return m.flow.NewSucceeded(state, state.UpdatedAt)
+	case m.flow.FailedType:
+		return m.flow.NewFailed(state, state.Reason, state.ErrorCode, state.UpdatedAt)
+	}
+
+	unboundReason := ReasonUnboundVerificationFailed
+	if state.Reason == ReasonTimeout {
+		unboundReason = ReasonUnboundVerificationTimeout
+	}
+	return m.flow.NewUnbound(state, unboundReason, state.UpdatedAt)
+}
+
+func (m *ProcessManager) timeoutKey(userID uuid.UUID) string {
+	return fmt.Sprintf("verification-timeout:%s:%s", m.flow.Source, userID)
+}
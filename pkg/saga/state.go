@@ -0,0 +1,97 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/caching"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+)
+
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusVerified Status = "verified"
+	StatusFailed   Status = "failed"
+)
+
+// State is the persisted progress of a single user's verification flow
+type State struct {
+	UserID    uuid.UUID     `json:"user_id"`
+	Source    events.Source `json:"source"`
+	Username  string        `json:"username"`
+	Status    Status        `json:"status"`
+	BoundAt   time.Time     `json:"bound_at"`
+	Deadline  time.Time     `json:"deadline"`
+	UpdatedAt time.Time     `json:"updated_at"`
+	Reason    string        `json:"reason,omitempty"`
+	ErrorCode string        `json:"error_code,omitempty"`
+	// Outbox lists the event types the new status still has to emit, in order. The state
+	// is saved before they are produced, so a retry emits what is left
+	Outbox []string `json:"outbox,omitempty"`
+}
+
+// Store persists flow state so it survives restarts
+type Store interface {
+	// Load returns nil without error when no flow exists for the user
+	Load(ctx context.Context, source events.Source, userID uuid.UUID) (*State, error)
+	Save(ctx context.Context, state *State) error
+	Delete(ctx context.Context, source events.Source, userID uuid.UUID) error
+}
+
+// CacheStore keeps flow state in a CacheService as JSON
+type CacheStore struct {
+	cache     caching.CacheService
+	retention time.Duration
+}
+
+// Ensure CacheStore implements Store
+var _ Store = (*CacheStore)(nil)
+
+// NewCacheStore creates a Store on top of the cache. Retention bounds how long state is kept
+// after its last update, zero keeps it forever
+func NewCacheStore(cache caching.CacheService, retention time.Duration) *CacheStore {
+	return &CacheStore{cache: cache, retention: retention}
+}
+
+func (s *CacheStore) Load(ctx context.Context, source events.Source, userID uuid.UUID) (*State, error) {
+	val, err := s.cache.Get(ctx, stateKey(source, userID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load flow state: %w", err)
+	}
+	if val == "" {
+		return nil, nil
+	}
+
+	var state State
+	if err := json.Unmarshal([]byte(val), &state); err != nil {
+		return nil, fmt.Errorf("failed to decode flow state: %w", err)
+	}
+	return &state, nil
+}
+
+func (s *CacheStore) Save(ctx context.Context, state *State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode flow state: %w", err)
+	}
+	if err := s.cache.Set(ctx, stateKey(state.Source, state.UserID), data, s.retention); err != nil {
+		return fmt.Errorf("failed to save flow state: %w", err)
+	}
+	return nil
+}
+
+func (s *CacheStore) Delete(ctx context.Context, source events.Source, userID uuid.UUID) error {
+	if err := s.cache.Delete(ctx, stateKey(source, userID)); err != nil {
+		return fmt.Errorf("failed to delete flow state: %w", err)
+	}
+	return nil
+}
+
+func stateKey(source events.Source, userID uuid.UUID) string {
+	return fmt.Sprintf("verification:%s:%s", source, userID)
+}
@@ -0,0 +1,109 @@
+package socialgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+var (
+	ErrSelfFollow    = errors.New("users cannot follow themselves")
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// Store keeps the follow relationships between users. Lists are ordered by follow time,
+// newest first
+type Store interface {
+	// Follow returns false when the follower already follows the followee
+	Follow(ctx context.Context, follower, followee uuid.UUID, at time.Time) (bool, error)
+	// Unfollow returns false when there was no relationship
+	Unfollow(ctx context.Context, follower, followee uuid.UUID) (bool, error)
+
+	IsFollowing(ctx context.Context, follower, followee uuid.UUID) (bool, error)
+	// IsMutual reports whether both users follow each other
+	IsMutual(ctx context.Context, a, b uuid.UUID) (bool, error)
+
+	// Followers lists the users following userID. An empty cursor starts at the newest
+	Followers(ctx context.Context, userID uuid.UUID, cursor string, limit int) (Page, error)
+	// Following lists the users userID follows. An empty cursor starts at the newest
+	Following(ctx context.Context, userID uuid.UUID, cursor string, limit int) (Page, error)
+
+	Counts(ctx context.Context, userID uuid.UUID) (Counts, error)
+}
+
+// Edge is one relationship in a list: the other user and since when it exists
+type Edge struct {
+	UserID uuid.UUID `json:"user_id"`
+	Since  time.Time `json:"since"`
+}
+
+// Page is a slice of a list. NextCursor is empty on the last page
+type Page struct {
+	Edges      []Edge `json:"edges"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type Counts struct {
+	Followers int64 `json:"followers"`
+	Following int64 `json:"following"`
+}
+
+// cursor points right after an edge: lists are ordered by follow time then user id,
+// both descending, so the pair identifies the position even when times are equal
+type cursor struct {
+	since  int64 // unix milliseconds
+	userID string
+}
+
+func (c cursor) String() string {
+	return strconv.FormatInt(c.since, 10) + ":" + c.userID
+}
+
+func parseCursor(s string) (*cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	since, userID, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+	ms, err := strconv.ParseInt(since, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor{since: ms, userID: userID}, nil
+}
+
+func pageSize(limit int) int {
+	switch {
+	case limit <= 0:
+		return DefaultPageSize
+	case limit > MaxPageSize:
+		return MaxPageSize
+	}
+	return limit
+}
+
+func checkPair(follower, followee uuid.UUID) error {
+	if follower == uuid.Nil || followee == uuid.Nil {
+		return fmt.Errorf("missing user id")
+	}
+	if follower == followee {
+		return ErrSelfFollow
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package socialgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/metacode-dream-team/MetaCode/pkg/caching"
+	"github.com/redis/go-redis/v9"
+)
+
+// followScript adds the relationship to both sorted sets at once. Returns 1 when it is new
+var followScript = redis.NewScript(`
+local added = redis.call('ZADD', KEYS[1], 'NX', ARGV[1], ARGV[2])
+redis.call('ZADD', KEYS[2], 'NX', ARGV[1], ARGV[3])
+return added
+`)
+
+// unfollowScript removes the relationship from both sorted sets. Returns 1 when it existed
+var unfollowScript = redis.NewScript(`
+local removed = redis.call('ZREM', KEYS[1], ARGV[1])
+redis.call('ZREM', KEYS[2], ARGV[2])
+return removed
+`)
+
+// RedisStore keeps two sorted sets per user, scored by follow time in milliseconds:
+// <prefix>:following:<user> and <prefix>:followers:<user>
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// Ensure RedisStore implements Store
+var _ Store = (*RedisStore)(nil)
+
+// NewRedisStore connects to Redis. The prefix defaults to "socialgraph"
+func NewRedisStore(cfg caching.RedisConfig, prefix string) (*RedisStore, error) {
+	if prefix == "" {
+		prefix = "socialgraph"
+	}
+
+	client, err := caching.NewRedisClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisStore{client: client, prefix: prefix}, nil
+}
+
+func (s *RedisStore) Follow(ctx context.Context, follower, followee uuid.UUID, at time.Time) (bool, error) {
+	if err := checkPair(follower, followee); err != nil {
+		return false, err
+	}
+
+	keys := []string{s.followingKey(follower), s.followersKey(followee)}
+	added, err := followScript.Run(ctx, s.client, keys, at.UnixMilli(), followee.String(), follower.String()).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to follow: %w", err)
+	}
+	return added == 1, nil
+}
+
+func (s *RedisStore) Unfollow(ctx context.Context, follower, followee uuid.UUID) (bool, error) {
+	if err := checkPair(follower, followee); err != nil {
+		return false, err
+	}
+
+	keys := []string{s.followingKey(follower), s.followersKey(followee)}
+	removed, err := unfollowScript.Run(ctx, s.client, keys, followee.String(), follower.String()).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to unfollow: %w", err)
+	}
+	return removed == 1, nil
+}
+
+func (s *RedisStore) IsFollowing(ctx context.Context, follower, followee uuid.UUID) (bool, error) {
+	err := s.client.ZScore(ctx, s.followingKey(follower), followee.String()).Err()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check relationship: %w", err)
+	}
+	return true, nil
+}
+
+func (s *RedisStore) IsMutual(ctx context.Context, a, b uuid.UUID) (bool, error) {
+	var ab, ba *redis.FloatCmd
+	_, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		ab = pipe.ZScore(ctx, s.followingKey(a), b.String())
+		ba = pipe.ZScore(ctx, s.followingKey(b), a.String())
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return false, fmt.Errorf("failed to check relationship: %w", err)
+	}
+	return ab.Err() == nil && ba.Err() == nil, nil
+}
+
+func (s *RedisStore) Followers(ctx context.Context, userID uuid.UUID, cursor string, limit int) (Page, error) {
+	return s.page(ctx, s.followersKey(userID), cursor, limit)
+}
+
+func (s *RedisStore) Following(ctx context.Context, userID uuid.UUID, cursor string, limit int) (Page, error) {
+	return s.page(ctx, s.followingKey(userID), cursor, limit)
+}
+
+func (s *RedisStore) Counts(ctx context.Context, userID uuid.UUID) (Counts, error) {
+	var followers, following *redis.IntCmd
+	_, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		followers = pipe.ZCard(ctx, s.followersKey(userID))
+		following = pipe.ZCard(ctx, s.followingKey(userID))
+		return nil
+	})
+	if err != nil {
+		return Counts{}, fmt.Errorf("failed to count relationships: %w", err)
+	}
+	return Counts{Followers: followers.Val(), Following: following.Val()}, nil
+}
+
+// page reads one page newest first, starting right after the cursor. Entries sharing the
+// cursor's score are skipped up to the cursor's member
+func (s *RedisStore) page(ctx context.Context, key, rawCursor string, limit int) (Page, error) {
+	after, err := parseCursor(rawCursor)
+	if err != nil {
+		return Page{}, err
+	}
+	size := pageSize(limit)
+
+	upper := "+inf"
+	if after != nil {
+		upper = strconv.FormatInt(after.since, 10)
+	}
+
+	var edges []Edge
+	for offset := int64(0); len(edges) <= size; {
+		batch, err := s.client.ZRangeArgsWithScores(ctx, redis.ZRangeArgs{
+			Key: key,
+			// go-redis puts Stop first for reversed ranges
+			Start:   "-inf",
+			Stop:    upper,
+			ByScore: true,
+			Rev:     true,
+			Offset:  offset,
+			Count:   int64(size + 1),
+		}).Result()
+		if err != nil {
+			return Page{}, fmt.Errorf("failed to list relationships: %w", err)
+		}
+		offset += int64(len(batch))
+
+		for _, z := range batch {
+			member, _ := z.Member.(string)
+			since := int64(z.Score)
+			if after != nil && since == after.since && member >= after.userID {
+				continue
+			}
+
+			userID, err := uuid.Parse(member)
+			if err != nil {
+				continue
+			}
+			edges = append(edges, Edge{UserID: userID, Since: time.UnixMilli(since).UTC()})
+			if len(edges) > size {
+				break
+			}
+		}
+
+		if len(batch) <= size {
+			break
+		}
+	}
+
+	page := Page{Edges: edges}
+	if len(edges) > size {
+		page.Edges = edges[:size]
+		last := page.Edges[size-1]
+		page.NextCursor = cursor{since: last.Since.UnixMilli(), userID: last.UserID.String()}.String()
+	}
+	return page, nil
+}
+
+// Close gracefully closes Redis connection
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
+
+func (s *RedisStore) followingKey(userID uuid.UUID) string {
+	return s.prefix + ":following:" + userID.String()
+}
+
+func (s *RedisStore) followersKey(userID uuid.UUID) string {
+	return s.prefix + ":followers:" + userID.String()
+}
@@ -0,0 +1,188 @@
+package streak
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/metacode-dream-team/MetaCode/pkg/date"
+	"github.com/metacode-dream-team/MetaCode/pkg/events"
+)
+
+var DefaultMilestones = []int{7, 30, 100, 365}
+
+type Config struct {
+	// MinContributions a day needs across all sources to count. Default is 1
+	MinContributions int
+	// GracePeriod keeps a day open after local midnight, so a missed day only breaks the
+	// streak once the grace period is over
+	GracePeriod time.Duration
+	// Milestones are the streak lengths reported by Milestone. Default is DefaultMilestones
+	Milestones []int
+}
+
+// Input is the history of one user
+type Input struct {
+	// Days holds the contributions per local date, see Aggregate
+	Days map[string]int
+	// Frozen lists the dates already covered by a freeze token (streak.frozen events)
+	Frozen []string
+	// Freezes is the number of unused freeze tokens
+	Freezes int
+	// Timezone of the user, empty means UTC
+	Timezone string
+	Now      time.Time
+}
+
+type Result struct {
+	// Current is the length of the live streak, 0 when broken
+	Current int
+	// Longest is the longest streak in the history, including the current one
+	Longest int
+	// LastActiveDate is the latest date that counted, empty without any
+	LastActiveDate string
+	// FreezesUsed lists the missed dates that unused freeze tokens must cover to keep
+	// the current streak. The caller spends them and emits streak.frozen
+	FreezesUsed []string
+	// Broken is the length of the streak that ended with missed days no freeze could
+	// cover since LastActiveDate, 0 otherwise
+	Broken int
+}
+
+// Calculator computes streaks the same way for every service. Frozen days keep a streak
+// alive but do not make it longer
+type Calculator struct {
+	config Config
+}
+
+func NewCalculator(cfg Config) *Calculator {
+	if cfg.MinContributions <= 0 {
+		cfg.MinContributions = 1
+	}
+	if len(cfg.Milestones) == 0 {
+		cfg.Milestones = DefaultMilestones
+	}
+	milestones := append([]int(nil), cfg.Milestones...)
+	sort.Ints(milestones)
+	cfg.Milestones = milestones
+
+	return &Calculator{config: cfg}
+}
+
+// Aggregate sums the contributions of every source per local date. Each event reports the
+// running count of its source for the day, so the highest count per source wins. Events
+// without a date, produced before dates were introduced, cannot be placed and are skipped
+func Aggregate(contributions []events.TodayContributedEvent) map[string]int {
+	type key struct {
+		date   string
+		source events.Source
+	}
+
+	perSource := map[key]int{}
+	for _, e := range contributions {
+		if e.Date == "" {
+			continue
+		}
+		k := key{e.Date, e.Source}
+		if e.Count > perSource[k] {
+			perSource[k] = e.Count
+		}
+	}
+
+	days := map[string]int{}
+	for k, count := range perSource {
+		days[k.date] += count
+	}
+	return days
+}
+
+// Calculate walks the history of the user up to the local day of in.Now
+func (c *Calculator) Calculate(in Input) (Result, error) {
+	var res Result
+
+	loc, err := date.LoadLocation(in.Timezone)
+	if err != nil {
+		return res, err
+	}
+
+	active := map[string]bool{}
+	first := ""
+	for day, count := range in.Days {
+		if count < c.config.MinContributions {
+			continue
+		}
+		if _, err := time.Parse(date.DayLayout, day); err != nil {
+			return res, fmt.Errorf("invalid date %q: %w", day, err)
+		}
+		active[day] = true
+		if first == "" || day < first {
+			first = day
+		}
+	}
+	if first == "" {
+		return res, nil
+	}
+
+	frozen := map[string]bool{}
+	for _, day := range in.Frozen {
+		frozen[day] = true
+	}
+
+	today := date.Day(in.Now, loc)
+	// Yesterday stays open while the grace period lasts
+	open := today
+	if start, _ := date.DayBounds(in.Now, loc); in.Now.Before(start.Add(c.config.GracePeriod)) {
+		open = addDays(today, -1)
+	}
+
+	// Gaps before the last active day were settled when they happened: either a freeze
+	// covered them (Frozen) or they broke the streak
+	run := 0
+	var missed []string
+	for day := first; day <= today; day = addDays(day, 1) {
+		switch {
+		case active[day]:
+			if len(missed) > 0 {
+				run, missed = 0, nil
+			}
+			run++
+			res.LastActiveDate = day
+			if run > res.Longest {
+				res.Longest = run
+			}
+		case frozen[day], day >= open:
+			// covered, or still in progress
+		default:
+			missed = append(missed, day)
+		}
+	}
+
+	// The trailing gap can still be saved with unused freezes
+	switch {
+	case len(missed) == 0:
+		res.Current = run
+	case len(missed) <= in.Freezes:
+		res.Current = run
+		res.FreezesUsed = missed
+	default:
+		res.Broken = run
+	}
+	return res, nil
+}
+
+// Milestone returns the highest milestone passed when a streak grew from previous to
+// current, if any
+func (c *Calculator) Milestone(previous, current int) (int, bool) {
+	reached := 0
+	for _, m := range c.config.Milestones {
+		if previous < m && m <= current {
+			reached = m
+		}
+	}
+	return reached, reached > 0
+}
+
+func addDays(day string, n int) string {
+	t, _ := time.Parse(date.DayLayout, day)
+	return t.AddDate(0, 0, n).Format(date.DayLayout)
+}
@@ -2,4 +2,9 @@
 protoc -I proto \
     --go_out=pkg/pb --go_opt=paths=source_relative \
     --go-grpc_out=pkg/pb --go-grpc_opt=paths=source_relative \
-    notification/notification.proto
\ No newline at end of file
+    notification/notification.proto
+
+# Event payloads (messages only, no services)
+protoc -I proto \
+    --go_out=pkg/pb --go_opt=paths=source_relative \
+    events/*.proto